@@ -26,31 +26,25 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
-	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
@@ -170,13 +164,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.New(cassetteName,
-			recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
-			recorder.WithMatcher(vcrMatcherFunc(ctx)),
-			recorder.WithMode(vcrMode),
-			recorder.WithRealTransport(httpClient.Transport),
-			recorder.WithSkipRequestLatency(true),
-		)
+		r, err := vcr.NewRecorder(ctx, cassetteName, vcrMode, httpClient.Transport)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -214,79 +202,13 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
-// vcrSensitiveHeaderHook is an after capture hook to remove sensitive HTTP headers.
-func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
-	delete(i.Request.Headers, "Authorization")
-	delete(i.Request.Headers, "X-Amz-Security-Token")
-	return nil
-}
-
-// vcrMatcherFunc defines how VCR will match requests to stored interactions.
-func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
-	return func(r *http.Request, i cassette.Request) bool {
-		if r.Method != i.Method {
-			return false
-		}
-
-		if r.URL.String() != i.URL {
-			return false
-		}
-
-		if r.Body == nil {
-			return true
-		}
-
-		var b bytes.Buffer
-		if _, err := b.ReadFrom(r.Body); err != nil {
-			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-				"error": err,
-			})
-			return false
-		}
-
-		r.Body = io.NopCloser(&b)
-		body := b.String()
-		// If body matches identically, we are done.
-		if body == i.Body {
-			return true
-		}
-
-		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-		switch contentType := r.Header.Get("Content-Type"); contentType {
-		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-			// JSON might be the same, but reordered. Try parsing and comparing.
-			return tfjson.EqualStrings(body, i.Body)
-
-		case "application/xml":
-			// XML might be the same, but reordered. Try parsing and comparing.
-			var requestXML, cassetteXML any
-
-			if err := xml.Unmarshal([]byte(body), &requestXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			if err := xml.Unmarshal([]byte(i.Body), &cassetteXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			return reflect.DeepEqual(requestXML, cassetteXML)
-		}
-
-		return false
-	}
-}
-
 // vcrRandomnessSource returns a rand.Source for VCR testing
 //
 // In RECORD_ONLY mode, generates a new seed to use as a source. This seed is
 // saved to a file when the recorder is closed.
 // In REPLAY_ONLY mode, reads a seed from a file and creates a source from it.
+// In NEW_EPISODES mode, reads a seed from a file if one exists, otherwise generates
+// a new seed.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
 	testName := t.Name()
@@ -307,6 +229,16 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	switch vcrMode {
 	case recorder.ModeRecordOnly:
 		seed := rand.Int63()
+		s = &randomnessSource{
+			seed:   seed,
+			source: rand.NewSource(seed),
+		}
+	case recorder.ModeReplayWithNewEpisodes:
+		seed, err := readSeedFromFile(vcrSeedFile(vcr.Path(), testName))
+		if err != nil {
+			seed = rand.Int63()
+		}
+
 		s = &randomnessSource{
 			seed:   seed,
 			source: rand.NewSource(seed),
@@ -350,7 +282,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
+			if v, ok := meta.HTTPClient(ctx).Transport.(*vcr.Recorder); ok {
 				t.Log("stopping VCR recorder")
 				if err := v.Stop(); err != nil {
 					t.Error(err)
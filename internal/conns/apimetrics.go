@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// Environment variable specifying the path of a file to which an AWS API call metrics report is written
+	//
+	// When set, the number of calls, retries, throttles and errors and the latency of every AWS API
+	// operation are recorded per resource type and written as JSON when the provider process exits.
+	// Terraform can start more than one provider process in a run, so each process writes its own
+	// report with its process ID inserted before the file extension.
+	APICallMetricsFileEnvVar = "TF_AWS_API_CALL_METRICS_FILE"
+)
+
+// apiCallMetricsCollector returns the process-wide API call metrics, or nil if metrics are not enabled.
+var apiCallMetricsCollector = sync.OnceValue(func() *apiCallMetrics {
+	if os.Getenv(APICallMetricsFileEnvVar) == "" {
+		return nil
+	}
+
+	return newAPICallMetrics()
+})
+
+// WriteAPICallMetricsReport writes the AWS API call metrics report for this process to a file
+// named after the TF_AWS_API_CALL_METRICS_FILE environment variable and the process ID.
+// Reports are not merged, as latency percentiles cannot be combined across processes.
+// It does nothing if metrics are not enabled.
+func WriteAPICallMetricsReport() error {
+	metrics := apiCallMetricsCollector()
+	if metrics == nil {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(metrics.report(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(apiCallMetricsFilename(os.Getenv(APICallMetricsFileEnvVar), os.Getpid()), b, 0o600)
+}
+
+// apiCallMetricsFilename returns the report file name for a process, inserting the process ID before the extension.
+func apiCallMetricsFilename(filename string, pid int) string {
+	ext := filepath.Ext(filename)
+
+	return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(filename, ext), pid, ext)
+}
+
+type apiCallMetricsKey struct {
+	typeName           string
+	servicePackageName string
+	operation          string
+}
+
+type apiCallSamples struct {
+	calls     int
+	retries   int
+	throttles int
+	errors    int
+	latencies []time.Duration
+}
+
+func (s *apiCallSamples) merge(other *apiCallSamples) {
+	s.calls += other.calls
+	s.retries += other.retries
+	s.throttles += other.throttles
+	s.errors += other.errors
+	s.latencies = append(s.latencies, other.latencies...)
+}
+
+// apiCallMetrics accumulates the outcome of AWS API calls.
+type apiCallMetrics struct {
+	isErrorThrottle retry.IsErrorThrottle
+
+	mu      sync.Mutex
+	samples map[apiCallMetricsKey]*apiCallSamples
+}
+
+func newAPICallMetrics() *apiCallMetrics {
+	return &apiCallMetrics{
+		isErrorThrottle: retry.IsErrorThrottles(retry.DefaultThrottles),
+		samples:         make(map[apiCallMetricsKey]*apiCallSamples),
+	}
+}
+
+// record counts a single API call, including all of its attempts.
+func (m *apiCallMetrics) record(key apiCallMetricsKey, attempts []retry.AttemptResult, latency time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.samples[key]
+	if !ok {
+		s = &apiCallSamples{}
+		m.samples[key] = s
+	}
+
+	s.calls++
+	if len(attempts) > 1 {
+		s.retries += len(attempts) - 1
+	}
+	for _, attempt := range attempts {
+		if attempt.Err != nil && m.isErrorThrottle.IsErrorThrottle(attempt.Err) == aws.TrueTernary {
+			s.throttles++
+		}
+	}
+	if err != nil {
+		s.errors++
+	}
+	s.latencies = append(s.latencies, latency)
+}
+
+// apiOption returns an API client option which records the calls made by the specified service's client.
+func (m *apiCallMetrics) apiOption(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAPICallMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			key := apiCallMetricsKey{
+				servicePackageName: servicePackageName,
+				operation:          awsmiddleware.GetOperationName(ctx),
+			}
+			if inContext, ok := FromContext(ctx); ok {
+				key.typeName = inContext.TypeName()
+			}
+			var attempts []retry.AttemptResult
+			if v, ok := retry.GetAttemptResults(metadata); ok {
+				attempts = v.Results
+			}
+			m.record(key, attempts, time.Since(start), err)
+
+			return out, metadata, err
+		}), middleware.Before)
+	}
+}
+
+type apiCallMetricsReport struct {
+	ResourceTypes []resourceTypeAPICallMetrics `json:"resource_types"`
+}
+
+type resourceTypeAPICallMetrics struct {
+	// ResourceType is empty for calls made outside of a resource, e.g. during provider configuration.
+	ResourceType string `json:"resource_type"`
+	apiCallStats
+	Operations []operationAPICallMetrics `json:"operations"`
+}
+
+type operationAPICallMetrics struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	apiCallStats
+}
+
+type apiCallStats struct {
+	Calls     int            `json:"calls"`
+	Retries   int            `json:"retries"`
+	Throttles int            `json:"throttles"`
+	Errors    int            `json:"errors"`
+	Latency   latencySummary `json:"latency_ms"`
+}
+
+type latencySummary struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// report summarizes the recorded API calls by resource type and operation.
+// Resource types and their operations are ordered by descending number of calls.
+func (m *apiCallMetrics) report() apiCallMetricsReport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	totals := make(map[string]*apiCallSamples)
+	operations := make(map[string][]operationAPICallMetrics)
+	for key, s := range m.samples {
+		total, ok := totals[key.typeName]
+		if !ok {
+			total = &apiCallSamples{}
+			totals[key.typeName] = total
+		}
+		total.merge(s)
+
+		operations[key.typeName] = append(operations[key.typeName], operationAPICallMetrics{
+			Service:      key.servicePackageName,
+			Operation:    key.operation,
+			apiCallStats: s.stats(),
+		})
+	}
+
+	report := apiCallMetricsReport{
+		ResourceTypes: make([]resourceTypeAPICallMetrics, 0, len(totals)),
+	}
+	for typeName, total := range totals {
+		ops := operations[typeName]
+		slices.SortFunc(ops, func(a, b operationAPICallMetrics) int {
+			return cmp.Or(
+				cmp.Compare(b.Calls, a.Calls),
+				cmp.Compare(a.Service, b.Service),
+				cmp.Compare(a.Operation, b.Operation),
+			)
+		})
+
+		report.ResourceTypes = append(report.ResourceTypes, resourceTypeAPICallMetrics{
+			ResourceType: typeName,
+			apiCallStats: total.stats(),
+			Operations:   ops,
+		})
+	}
+	slices.SortFunc(report.ResourceTypes, func(a, b resourceTypeAPICallMetrics) int {
+		return cmp.Or(
+			cmp.Compare(b.Calls, a.Calls),
+			cmp.Compare(a.ResourceType, b.ResourceType),
+		)
+	})
+
+	return report
+}
+
+func (s *apiCallSamples) stats() apiCallStats {
+	latencies := slices.Clone(s.latencies)
+	slices.Sort(latencies)
+
+	return apiCallStats{
+		Calls:     s.calls,
+		Retries:   s.retries,
+		Throttles: s.throttles,
+		Errors:    s.errors,
+		Latency: latencySummary{
+			P50: percentileMilliseconds(latencies, 50),
+			P90: percentileMilliseconds(latencies, 90),
+			P99: percentileMilliseconds(latencies, 99),
+			Max: percentileMilliseconds(latencies, 100),
+		},
+	}
+}
+
+// percentileMilliseconds returns the nearest-rank percentile of the sorted latencies, in milliseconds.
+func percentileMilliseconds(sorted []time.Duration, percentile float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := max(int(math.Ceil(percentile/100*float64(len(sorted)))), 1)
+
+	return float64(sorted[rank-1]) / float64(time.Millisecond)
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAPICallMetricsReport(t *testing.T) {
+	t.Parallel()
+
+	m := newAPICallMetrics()
+	getRole := apiCallMetricsKey{typeName: "aws_iam_role", servicePackageName: names.IAM, operation: "GetRole"}
+	createRole := apiCallMetricsKey{typeName: "aws_iam_role", servicePackageName: names.IAM, operation: "CreateRole"}
+	getCallerIdentity := apiCallMetricsKey{servicePackageName: names.STS, operation: "GetCallerIdentity"}
+
+	for i := range 4 {
+		m.record(getRole, []retry.AttemptResult{{}}, time.Duration(i+1)*time.Millisecond, nil)
+	}
+	m.record(createRole, []retry.AttemptResult{{Err: throttlingError{}}, {Err: throttlingError{}}, {}}, 100*time.Millisecond, nil)
+	m.record(createRole, []retry.AttemptResult{{Err: errors.New("access denied")}}, 10*time.Millisecond, errors.New("access denied"))
+	m.record(getCallerIdentity, nil, 20*time.Millisecond, nil)
+
+	expected := apiCallMetricsReport{
+		ResourceTypes: []resourceTypeAPICallMetrics{
+			{
+				ResourceType: "aws_iam_role",
+				apiCallStats: apiCallStats{
+					Calls:     6,
+					Retries:   2,
+					Throttles: 2,
+					Errors:    1,
+					Latency:   latencySummary{P50: 3, P90: 100, P99: 100, Max: 100},
+				},
+				Operations: []operationAPICallMetrics{
+					{
+						Service:   names.IAM,
+						Operation: "GetRole",
+						apiCallStats: apiCallStats{
+							Calls:   4,
+							Latency: latencySummary{P50: 2, P90: 4, P99: 4, Max: 4},
+						},
+					},
+					{
+						Service:   names.IAM,
+						Operation: "CreateRole",
+						apiCallStats: apiCallStats{
+							Calls:     2,
+							Retries:   2,
+							Throttles: 2,
+							Errors:    1,
+							Latency:   latencySummary{P50: 10, P90: 100, P99: 100, Max: 100},
+						},
+					},
+				},
+			},
+			{
+				ResourceType: "",
+				apiCallStats: apiCallStats{
+					Calls:   1,
+					Latency: latencySummary{P50: 20, P90: 20, P99: 20, Max: 20},
+				},
+				Operations: []operationAPICallMetrics{
+					{
+						Service:   names.STS,
+						Operation: "GetCallerIdentity",
+						apiCallStats: apiCallStats{
+							Calls:   1,
+							Latency: latencySummary{P50: 20, P90: 20, P99: 20, Max: 20},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, m.report(), cmp.AllowUnexported(resourceTypeAPICallMetrics{}, operationAPICallMetrics{})); diff != "" {
+		t.Errorf("unexpected diff: %s", diff)
+	}
+}
+
+func TestAPICallMetricsFilename(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		filename string
+		expected string
+	}{
+		"extension": {
+			filename: "aws-api-calls.json",
+			expected: "aws-api-calls.1234.json",
+		},
+		"no extension": {
+			filename: "aws-api-calls",
+			expected: "aws-api-calls.1234",
+		},
+		"directory": {
+			filename: "/tmp/metrics.d/aws-api-calls.json",
+			expected: "/tmp/metrics.d/aws-api-calls.1234.json",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := apiCallMetricsFilename(testCase.filename, 1234), testCase.expected; got != want {
+				t.Errorf("apiCallMetricsFilename() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -31,6 +32,7 @@ import (
 type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
+	circuitBreakers           *circuitBreakers          // From provider configuration.
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
@@ -40,6 +42,9 @@ type AWSClient struct {
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
 	randomnessSource          rand.Source // For VCR deterministic randomness.
+	requestRateLimiters       *requestRateLimiters
+	retryBudget               *retryBudget                   // From provider configuration.
+	serviceLimits             map[string]ServiceLimitsConfig // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -78,11 +83,21 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// Any scoped default tags are resolved for the resource type in Context.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(inContext.ServicePackageName(), inContext.TypeName())
+	}
 	return c.defaultTagsConfig
 }
 
-func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
+// IgnoreTagsConfig returns the provider's ignore tags configuration.
+// Any scoped ignore tags are resolved for the resource type in Context.
+func (c *AWSClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.ignoreTagsConfig.ForResource(inContext.ServicePackageName(), inContext.TypeName())
+	}
 	return c.ignoreTagsConfig
 }
 
@@ -354,7 +369,7 @@ func convertIPToDashIP(ip string) string {
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": c.serviceAWSConfig(servicePackageName),
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -378,6 +393,45 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// serviceAWSConfig returns the AWS SDK for Go v2 configuration for the specified service.
+// If a retry budget, circuit breaker or service limits are configured the service's retryer is wrapped accordingly.
+// If API call metrics are enabled the service's API calls are recorded.
+func (c *AWSClient) serviceAWSConfig(servicePackageName string) *aws.Config {
+	limits := c.serviceLimits[servicePackageName]
+	metrics := apiCallMetricsCollector()
+	if c.awsConfig == nil || (c.retryBudget == nil && c.circuitBreakers == nil && limits == (ServiceLimitsConfig{}) && metrics == nil) {
+		return c.awsConfig
+	}
+
+	var breaker *circuitBreaker
+	if c.circuitBreakers != nil {
+		breaker = c.circuitBreakers.get(servicePackageName)
+	}
+	var limiter *requestRateLimiter
+	if c.requestRateLimiters != nil {
+		limiter = c.requestRateLimiters.get(servicePackageName)
+	}
+
+	cfg := c.awsConfig.Copy()
+	if metrics != nil {
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), metrics.apiOption(servicePackageName))
+	}
+	if limits.MaxRetries > 0 {
+		cfg.RetryMaxAttempts = limits.MaxRetries
+	}
+	if newRetryer := c.awsConfig.Retryer; newRetryer != nil && (c.retryBudget != nil || breaker != nil || limiter != nil) {
+		cfg.Retryer = func() aws.Retryer {
+			r := newRetryer()
+			if v, ok := r.(aws.RetryerV2); ok {
+				return withRequestGuards(v, c.retryBudget, breaker, limiter)
+			}
+			return r
+		}
+	}
+
+	return &cfg
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CircuitBreakerConfig contains options for failing fast on calls to an AWS service
+// whose recent requests have mostly failed with retryable errors.
+type CircuitBreakerConfig struct {
+	// ErrorThreshold is the fraction, between 0 and 1, of requests in a window
+	// failing with retryable errors above which the circuit breaker opens
+	ErrorThreshold float64
+	// MinimumRequests is the number of requests in a window below which the
+	// circuit breaker does not open
+	MinimumRequests int
+	// Window is the period over which requests are counted and for which an open
+	// circuit breaker remains open
+	Window time.Duration
+}
+
+// CircuitBreakerOpenError is returned for AWS API requests which are not
+// attempted because the service's circuit breaker is open.
+type CircuitBreakerOpenError struct {
+	ServicePackageName string
+	Failures           int
+	Requests           int
+	Until              time.Time
+}
+
+func (e *CircuitBreakerOpenError) Error() string {
+	serviceName, err := names.HumanFriendly(e.ServicePackageName)
+	if err != nil {
+		serviceName = e.ServicePackageName
+	}
+
+	return fmt.Sprintf("%s API requests are failing fast until %s: %d of %d recent requests failed with retryable errors (circuit breaker open)",
+		serviceName, e.Until.Format(time.RFC3339), e.Failures, e.Requests)
+}
+
+// RetryBudgetExhaustedError is returned for AWS API requests which are not
+// retried because the provider-wide retry budget is exhausted.
+type RetryBudgetExhaustedError struct {
+	Budget time.Duration
+	Err    error
+}
+
+func (e *RetryBudgetExhaustedError) Error() string {
+	return fmt.Sprintf("provider retry budget (%s) exhausted, not retrying: %s", e.Budget, e.Err)
+}
+
+func (e *RetryBudgetExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+// retryBudget limits the total time spent waiting between retries across all AWS API clients.
+type retryBudget struct {
+	budget    time.Duration
+	remaining atomic.Int64
+}
+
+func newRetryBudget(budget time.Duration) *retryBudget {
+	b := &retryBudget{
+		budget: budget,
+	}
+	b.remaining.Store(int64(budget))
+
+	return b
+}
+
+// consume deducts the specified delay from the budget, returning false if the budget is exhausted.
+func (b *retryBudget) consume(delay time.Duration) bool {
+	for {
+		remaining := b.remaining.Load()
+		if remaining < int64(delay) {
+			return false
+		}
+		if b.remaining.CompareAndSwap(remaining, remaining-int64(delay)) {
+			return true
+		}
+	}
+}
+
+// circuitBreaker tracks the outcome of requests to a single AWS service.
+type circuitBreaker struct {
+	config             CircuitBreakerConfig
+	servicePackageName string
+	now                func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	requests    int
+	failures    int
+	openUntil   time.Time
+	// openRequests and openFailures are the counts which opened the circuit breaker.
+	openRequests int
+	openFailures int
+}
+
+func newCircuitBreaker(servicePackageName string, config CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		config:             config,
+		servicePackageName: servicePackageName,
+		now:                time.Now,
+	}
+}
+
+// allow returns an error if the circuit breaker is open.
+func (cb *circuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if now := cb.now(); now.Before(cb.openUntil) {
+		return &CircuitBreakerOpenError{
+			ServicePackageName: cb.servicePackageName,
+			Failures:           cb.openFailures,
+			Requests:           cb.openRequests,
+			Until:              cb.openUntil,
+		}
+	}
+
+	return nil
+}
+
+// record counts the outcome of a request, opening the circuit breaker if the error threshold is exceeded.
+func (cb *circuitBreaker) record(failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := cb.now()
+	if now.Before(cb.openUntil) {
+		return
+	}
+
+	if now.Sub(cb.windowStart) >= cb.config.Window {
+		cb.windowStart = now
+		cb.requests, cb.failures = 0, 0
+	}
+
+	cb.requests++
+	if failed {
+		cb.failures++
+	}
+
+	if cb.requests >= cb.config.MinimumRequests && float64(cb.failures)/float64(cb.requests) > cb.config.ErrorThreshold {
+		cb.openUntil = now.Add(cb.config.Window)
+		cb.openRequests, cb.openFailures = cb.requests, cb.failures
+		// Start a new window once the circuit breaker closes again.
+		cb.windowStart = cb.openUntil
+		cb.requests, cb.failures = 0, 0
+	}
+}
+
+// circuitBreakers holds the circuit breaker for each AWS service.
+type circuitBreakers struct {
+	config CircuitBreakerConfig
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newCircuitBreakers(config CircuitBreakerConfig) *circuitBreakers {
+	return &circuitBreakers{
+		config:   config,
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+func (cbs *circuitBreakers) get(servicePackageName string) *circuitBreaker {
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+
+	cb, ok := cbs.breakers[servicePackageName]
+	if !ok {
+		cb = newCircuitBreaker(servicePackageName, cbs.config)
+		cbs.breakers[servicePackageName] = cb
+	}
+
+	return cb
+}
+
+// withRequestGuards returns a Retryer which fails fast when the circuit breaker is open,
+// waits for the request rate limiter before each attempt and stops retrying once the
+// retry budget is exhausted. Any of budget, breaker or limiter may be nil.
+func withRequestGuards(r aws.RetryerV2, budget *retryBudget, breaker *circuitBreaker, limiter *requestRateLimiter) aws.RetryerV2 {
+	return &guardedRetryer{
+		RetryerV2: r,
+		budget:    budget,
+		breaker:   breaker,
+		limiter:   limiter,
+	}
+}
+
+type guardedRetryer struct {
+	aws.RetryerV2
+	budget  *retryBudget
+	breaker *circuitBreaker
+	limiter *requestRateLimiter
+}
+
+func (r *guardedRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if r.breaker != nil {
+		if err := r.breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.limiter != nil {
+		if err := r.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.breaker == nil {
+		return release, nil
+	}
+
+	return func(err error) error {
+		// Only retryable errors (throttling, transient service errors) indicate an unhealthy service.
+		r.breaker.record(err != nil && r.RetryerV2.IsErrorRetryable(err))
+		return release(err)
+	}, nil
+}
+
+func (r *guardedRetryer) RetryDelay(attempt int, err error) (time.Duration, error) {
+	delay, delayErr := r.RetryerV2.RetryDelay(attempt, err)
+	if delayErr != nil {
+		return delay, delayErr
+	}
+
+	if r.budget != nil && !r.budget.consume(delay) {
+		return 0, &RetryBudgetExhaustedError{
+			Budget: r.budget.budget,
+			Err:    err,
+		}
+	}
+
+	return delay, nil
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRetryBudgetConsume(t *testing.T) {
+	t.Parallel()
+
+	b := newRetryBudget(10 * time.Second)
+
+	if !b.consume(4 * time.Second) {
+		t.Fatal("expected first delay to be within budget")
+	}
+	if !b.consume(6 * time.Second) {
+		t.Fatal("expected second delay to be within budget")
+	}
+	if b.consume(1 * time.Nanosecond) {
+		t.Fatal("expected budget to be exhausted")
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		outcomes []bool
+		advance  time.Duration
+		wantOpen bool
+	}{
+		{
+			name:     "below minimum requests",
+			outcomes: []bool{true, true, true},
+		},
+		{
+			name:     "below error threshold",
+			outcomes: []bool{true, true, false, false},
+		},
+		{
+			name:     "above error threshold",
+			outcomes: []bool{true, true, true, false},
+			wantOpen: true,
+		},
+		{
+			name:     "window elapsed",
+			outcomes: []bool{true, true, true, false},
+			advance:  time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+			cb := newCircuitBreaker(names.Route53, CircuitBreakerConfig{
+				ErrorThreshold:  0.5,
+				MinimumRequests: 4,
+				Window:          time.Minute,
+			})
+			cb.now = func() time.Time { return now }
+
+			for _, failed := range testCase.outcomes {
+				cb.record(failed)
+			}
+			now = now.Add(testCase.advance)
+
+			err := cb.allow()
+			if got, want := err != nil, testCase.wantOpen; got != want {
+				t.Fatalf("circuit breaker open = %t, want %t (err: %v)", got, want, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "Route 53") {
+				t.Errorf("error %q does not name the service", err)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerClosesAfterOpenPeriod(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	cb := newCircuitBreaker(names.Route53, CircuitBreakerConfig{
+		ErrorThreshold:  0.5,
+		MinimumRequests: 4,
+		Window:          time.Minute,
+	})
+	cb.now = func() time.Time { return now }
+
+	for _, failed := range []bool{true, true, true, false, false} {
+		cb.record(failed)
+	}
+	if err := cb.allow(); err == nil {
+		t.Fatal("expected circuit breaker to be open")
+	}
+
+	now = now.Add(time.Minute)
+	cb.record(false)
+
+	if err := cb.allow(); err != nil {
+		t.Fatalf("expected circuit breaker to remain closed after a successful request, got: %s", err)
+	}
+}
+
+func TestGuardedRetryerCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cb := newCircuitBreaker(names.EC2, CircuitBreakerConfig{
+		ErrorThreshold:  0.5,
+		MinimumRequests: 2,
+		Window:          time.Minute,
+	})
+	r := withRequestGuards(retry.NewStandard(), nil, cb, nil)
+
+	for range 2 {
+		release, err := r.GetAttemptToken(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = release(throttlingError{})
+	}
+
+	_, err := r.GetAttemptToken(ctx)
+	if _, ok := errs.As[*CircuitBreakerOpenError](err); !ok {
+		t.Fatalf("expected CircuitBreakerOpenError, got: %v", err)
+	}
+}
+
+func TestGuardedRetryerRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	r := withRequestGuards(retry.NewStandard(func(o *retry.StandardOptions) {
+		o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+			return time.Second, nil
+		})
+	}), newRetryBudget(time.Second), nil, nil)
+	origErr := throttlingError{}
+
+	if _, err := r.RetryDelay(1, origErr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err := r.RetryDelay(2, origErr)
+	if _, ok := errs.As[*RetryBudgetExhaustedError](err); !ok {
+		t.Fatalf("expected RetryBudgetExhaustedError, got: %v", err)
+	}
+	if !errors.Is(err, origErr) {
+		t.Errorf("expected error to wrap original error, got: %v", err)
+	}
+}
+
+type throttlingError struct{}
+
+func (throttlingError) Error() string     { return "throttled" }
+func (throttlingError) ErrorCode() string { return "Throttling" }
@@ -6,11 +6,13 @@ package conns
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -30,8 +32,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIOptions                     []func(*middleware.Stack) error
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CircuitBreakerConfig           *CircuitBreakerConfig
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -47,11 +51,13 @@ type Config struct {
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	RetryBudget                    time.Duration
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceLimits                  map[string]ServiceLimitsConfig
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -212,7 +218,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	switch {
+	case c.TagPolicyConfig != nil && c.TagPolicyConfig.Document != "":
+		tflog.Debug(ctx, "Parsing tag policy document")
+		reqTags, tagRules, err := tagpolicy.ParseDocument(ctx, c.TagPolicyConfig.Document)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Parsing Tag Policy Document",
+				`Failed to parse the configured tag policy document. Ensure the document is a valid organizations tag policy.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.RequiredTags = reqTags
+		c.TagPolicyConfig.TagRules = tagRules
+	case c.TagPolicyConfig != nil:
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -224,6 +243,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		tagRules, err := tagpolicy.GetTagRules(ctx, cfg)
+		if err != nil {
+			// Required tag enforcement does not depend on tag rules, so continue without them.
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Tag Policy Rules",
+				`Failed to retrieve tag key capitalization and allowed tag values from the effective tag policy. `+
+					`Only required tags will be enforced. Ensure the calling principal has the `+
+					`"organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+		}
+		c.TagPolicyConfig.TagRules = tagRules
 	}
 
 	client.accountID = accountID
@@ -232,11 +263,25 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
+	if len(c.APIOptions) > 0 {
+		cfg.APIOptions = slices.Concat(cfg.APIOptions, c.APIOptions)
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	if c.CircuitBreakerConfig != nil {
+		client.circuitBreakers = newCircuitBreakers(*c.CircuitBreakerConfig)
+	}
+	if c.RetryBudget > 0 {
+		client.retryBudget = newRetryBudget(c.RetryBudget)
+	}
+	if len(c.ServiceLimits) > 0 {
+		client.requestRateLimiters = newRequestRateLimiters(c.ServiceLimits)
+		client.serviceLimits = c.ServiceLimits
+	}
 	client.s3OriginalRegion = c.S3OriginalRegion
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ServiceLimitsConfig contains client-side limits on requests to a single AWS service.
+type ServiceLimitsConfig struct {
+	// MaxRetries is the maximum number of times an API request is attempted.
+	// Zero means the provider-wide value is used
+	MaxRetries int
+	// RequestsPerSecond is the maximum rate at which API requests, including retries, are sent.
+	// Zero means requests are not rate limited
+	RequestsPerSecond float64
+}
+
+// requestRateLimiter spaces requests evenly so that the configured rate is not exceeded.
+type requestRateLimiter struct {
+	interval time.Duration
+	now      func() time.Time
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRequestRateLimiter(requestsPerSecond float64) *requestRateLimiter {
+	return &requestRateLimiter{
+		interval: time.Duration(float64(time.Second) / requestsPerSecond),
+		now:      time.Now,
+	}
+}
+
+// reserve returns the delay before a request may be sent.
+func (l *requestRateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	return delay
+}
+
+// wait blocks until a request may be sent or the context is done.
+func (l *requestRateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// requestRateLimiters holds the request rate limiter for each AWS service with a configured rate limit.
+type requestRateLimiters struct {
+	limits map[string]ServiceLimitsConfig
+
+	mu       sync.Mutex
+	limiters map[string]*requestRateLimiter
+}
+
+func newRequestRateLimiters(limits map[string]ServiceLimitsConfig) *requestRateLimiters {
+	return &requestRateLimiters{
+		limits:   limits,
+		limiters: make(map[string]*requestRateLimiter),
+	}
+}
+
+// get returns the specified service's request rate limiter, or nil if the service's requests are not rate limited.
+func (rls *requestRateLimiters) get(servicePackageName string) *requestRateLimiter {
+	if rls.limits[servicePackageName].RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	rls.mu.Lock()
+	defer rls.mu.Unlock()
+
+	l, ok := rls.limiters[servicePackageName]
+	if !ok {
+		l = newRequestRateLimiter(rls.limits[servicePackageName].RequestsPerSecond)
+		rls.limiters[servicePackageName] = l
+	}
+
+	return l
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRequestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	l := newRequestRateLimiter(4)
+	l.now = func() time.Time { return now }
+
+	for i, want := range []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reservation %d: delay = %s, want %s", i, got, want)
+		}
+	}
+
+	// After an idle period requests are not delayed.
+	now = now.Add(time.Second)
+	if got := l.reserve(); got != 0 {
+		t.Errorf("after idle period: delay = %s, want 0", got)
+	}
+}
+
+func TestRequestRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newRequestRateLimiter(0.001)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRequestRateLimitersGet(t *testing.T) {
+	t.Parallel()
+
+	rls := newRequestRateLimiters(map[string]ServiceLimitsConfig{
+		names.IAM:     {MaxRetries: 5},
+		names.Route53: {RequestsPerSecond: 2},
+	})
+
+	if l := rls.get(names.IAM); l != nil {
+		t.Errorf("expected no rate limiter for %s", names.IAM)
+	}
+	if l := rls.get(names.EC2); l != nil {
+		t.Errorf("expected no rate limiter for %s", names.EC2)
+	}
+	l := rls.get(names.Route53)
+	if l == nil {
+		t.Fatalf("expected rate limiter for %s", names.Route53)
+	}
+	if l != rls.get(names.Route53) {
+		t.Errorf("expected rate limiter for %s to be shared", names.Route53)
+	}
+}
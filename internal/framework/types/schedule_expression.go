@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+var (
+	_ basetypes.StringTypable = (*scheduleExpressionType)(nil)
+)
+
+type scheduleExpressionType struct {
+	basetypes.StringType
+}
+
+var (
+	ScheduleExpressionType = scheduleExpressionType{}
+)
+
+func (t scheduleExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(scheduleExpressionType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (scheduleExpressionType) String() string {
+	return "ScheduleExpressionType"
+}
+
+func (t scheduleExpressionType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return ScheduleExpressionNull(), diags
+	}
+	if in.IsUnknown() {
+		return ScheduleExpressionUnknown(), diags
+	}
+
+	// The ValidateAttribute method will surface errors if the value is an invalid
+	// schedule expression. This method simply passes the value through.
+	return ScheduleExpressionValue(in.ValueString()), diags
+}
+
+func (t scheduleExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (scheduleExpressionType) ValueType(context.Context) attr.Value {
+	return ScheduleExpression{}
+}
+
+var (
+	_ basetypes.StringValuable    = (*ScheduleExpression)(nil)
+	_ xattr.ValidateableAttribute = (*ScheduleExpression)(nil)
+)
+
+func ScheduleExpressionNull() ScheduleExpression {
+	return ScheduleExpression{StringValue: basetypes.NewStringNull()}
+}
+
+func ScheduleExpressionUnknown() ScheduleExpression {
+	return ScheduleExpression{StringValue: basetypes.NewStringUnknown()}
+}
+
+// ScheduleExpressionValue initializes a new ScheduleExpression type with the provided value
+//
+// This function does not return diagnostics, and therefore invalid schedule expression values
+// are not handled during construction. Invalid values will be detected by the
+// ValidateAttribute method, called by the ValidateResourceConfig RPC during
+// operations like `terraform validate`, `plan`, or `apply`.
+func ScheduleExpressionValue(value string) ScheduleExpression {
+	// swallow any schedule expression parsing errors here and just pass along the
+	// zero value schedule.Expression. Invalid values will be handled downstream
+	// by the ValidateAttribute method.
+	v, _ := schedule.Parse(value)
+
+	return ScheduleExpression{
+		StringValue: basetypes.NewStringValue(value),
+		value:       v,
+	}
+}
+
+// ScheduleExpression is an AWS schedule expression: at(...), cron(...) or rate(...).
+type ScheduleExpression struct {
+	basetypes.StringValue
+	value schedule.Expression
+}
+
+func (v ScheduleExpression) Equal(o attr.Value) bool {
+	other, ok := o.(ScheduleExpression)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (ScheduleExpression) Type(context.Context) attr.Type {
+	return ScheduleExpressionType
+}
+
+// ValueScheduleExpression returns the known schedule.Expression value. If ScheduleExpression is null, unknown or invalid, returns the zero value.
+func (v ScheduleExpression) ValueScheduleExpression() schedule.Expression {
+	return v.value
+}
+
+func (v ScheduleExpression) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	vs := v.ValueString()
+	if _, err := schedule.Parse(vs); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Schedule Expression Value",
+			"The provided value cannot be parsed as a schedule expression.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+vs+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
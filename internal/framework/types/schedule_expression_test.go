@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+func TestScheduleExpressionTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected fwtypes.ScheduleExpression
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.ScheduleExpressionNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.ScheduleExpressionUnknown(),
+		},
+		"valid ScheduleExpression": {
+			val:      tftypes.NewValue(tftypes.String, "rate(5 minutes)"),
+			expected: fwtypes.ScheduleExpressionValue("rate(5 minutes)"),
+		},
+		"invalid ScheduleExpression": {
+			val:      tftypes.NewValue(tftypes.String, "cron(0 12 * * *)"),
+			expected: fwtypes.ScheduleExpressionValue("cron(0 12 * * *)"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.ScheduleExpressionType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !test.expected.Equal(val) {
+				t.Errorf("unexpected diff: wanted %q, got %q", test.expected.String(), val.String())
+			}
+		})
+	}
+}
+
+func TestScheduleExpressionValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.ScheduleExpression
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.ScheduleExpressionUnknown(),
+		},
+		"null": {
+			val: fwtypes.ScheduleExpressionNull(),
+		},
+		"valid at": {
+			val: fwtypes.ScheduleExpressionValue("at(2026-10-17T09:30:00)"),
+		},
+		"valid cron": {
+			val: fwtypes.ScheduleExpressionValue("cron(0/15 9-17 ? * MON-FRI *)"),
+		},
+		"valid rate": {
+			val: fwtypes.ScheduleExpressionValue("rate(1 day)"),
+		},
+		"invalid cron": {
+			val:         fwtypes.ScheduleExpressionValue("cron(0 12 * * MON *)"),
+			expectError: true,
+		},
+		"invalid rate": {
+			val:         fwtypes.ScheduleExpressionValue("rate(1 days)"),
+			expectError: true,
+		},
+		"invalid": {
+			val:         fwtypes.ScheduleExpressionValue("0 12 * * ?"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestScheduleExpressionValueScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      fwtypes.ScheduleExpression
+		expected schedule.Kind
+	}{
+		"at": {
+			val:      fwtypes.ScheduleExpressionValue("at(2026-10-17T09:30:00)"),
+			expected: schedule.KindAt,
+		},
+		"cron": {
+			val:      fwtypes.ScheduleExpressionValue("cron(0 12 * * ? *)"),
+			expected: schedule.KindCron,
+		},
+		"rate": {
+			val:      fwtypes.ScheduleExpressionValue("rate(5 minutes)"),
+			expected: schedule.KindRate,
+		},
+		"null": {
+			val: fwtypes.ScheduleExpressionNull(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := test.val.ValueScheduleExpression().Kind(), test.expected; got != want {
+				t.Errorf("ValueScheduleExpression().Kind() = %q, want %q", got, want)
+			}
+		})
+	}
+}
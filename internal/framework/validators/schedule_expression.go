@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionValidator validates that a string Attribute's value is a valid AWS schedule expression.
+type scheduleExpressionValidator struct {
+	kinds []schedule.Kind
+}
+
+// Description describes the validation in plain text formatting.
+func (validator scheduleExpressionValidator) Description(_ context.Context) string {
+	if len(validator.kinds) == 0 {
+		return "value must be a valid schedule expression"
+	}
+
+	kinds := make([]string, 0, len(validator.kinds))
+	for _, kind := range validator.kinds {
+		kinds = append(kinds, string(kind)+"()")
+	}
+
+	return fmt.Sprintf("value must be a valid %s schedule expression", strings.Join(kinds, " or "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator scheduleExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	valueString := request.ConfigValue.ValueString()
+	expr, err := schedule.Parse(valueString)
+	if err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s (%s)", validator.Description(ctx), err),
+			valueString,
+		))
+		return
+	}
+
+	if len(validator.kinds) > 0 && !slices.Contains(validator.kinds, expr.Kind()) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			valueString,
+		))
+		return
+	}
+}
+
+// ScheduleExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid AWS schedule expression: at(...), cron(...) or rate(...).
+//   - If any kinds are specified, is an expression of one of those kinds.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ScheduleExpression(kinds ...schedule.Kind) validator.String {
+	return scheduleExpressionValidator{
+		kinds: kinds,
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+func TestScheduleExpressionValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val                 types.String
+		kinds               []schedule.Kind
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid at": {
+			val: types.StringValue("at(2026-10-17T09:30:00)"),
+		},
+		"valid cron": {
+			val: types.StringValue("cron(0 18 ? * MON-FRI *)"),
+		},
+		"valid rate": {
+			val: types.StringValue("rate(5 minutes)"),
+		},
+		"invalid String": {
+			val: types.StringValue("rate(5 minute)"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid schedule expression (rate expression "rate(5 minute)": unit "minute" must be plural for a value other than 1), got: rate(5 minute)`,
+				),
+			},
+		},
+		"allowed kind": {
+			val:   types.StringValue("cron(0 18 ? * MON-FRI *)"),
+			kinds: []schedule.Kind{schedule.KindCron, schedule.KindRate},
+		},
+		"disallowed kind": {
+			val:   types.StringValue("at(2026-10-17T09:30:00)"),
+			kinds: []schedule.Kind{schedule.KindCron, schedule.KindRate},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid cron() or rate() schedule expression, got: at(2026-10-17T09:30:00)`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.ScheduleExpression(test.kinds...).ValidateString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = arnLikeFunction{}
+
+func NewARNLikeFunction() function.Function {
+	return &arnLikeFunction{}
+}
+
+type arnLikeFunction struct{}
+
+func (f arnLikeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_like"
+}
+
+func (f arnLikeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "arn_like Function",
+		MarkdownDescription: "Checks whether an ARN matches a pattern, as the IAM ArnLike condition operator does",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to check",
+			},
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, in which `*` matches any sequence of characters and `?` matches any single character within a segment",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnLikeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn, pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arn, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateARNPattern(pattern); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, arnLike(arn, pattern)))
+}
+
+// arnLike returns whether the ARN matches the pattern. Each of the six colon-delimited segments is matched
+// separately, so that a wildcard doesn't match across segments. A value that isn't an ARN doesn't match.
+func arnLike(arn, pattern string) bool {
+	ok, _ := iamPolicyARNLike(arn, escapeIAMPolicyPattern(pattern))
+
+	return ok
+}
+
+// validateARNPattern returns an error if the pattern can't match any ARN.
+func validateARNPattern(pattern string) error {
+	const segments = 6
+
+	if parts := strings.SplitN(pattern, ":", segments); len(parts) != segments || !iamPolicyWildcardMatch(escapeIAMPolicyPattern(parts[0]), "arn") {
+		return fmt.Errorf("pattern must have %d colon-delimited segments, starting with \"arn\"", segments)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = arnLikeFilterFunction{}
+
+func NewARNLikeFilterFunction() function.Function {
+	return &arnLikeFilterFunction{}
+}
+
+type arnLikeFilterFunction struct{}
+
+func (f arnLikeFilterFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_like_filter"
+}
+
+func (f arnLikeFilterFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "arn_like_filter Function",
+		MarkdownDescription: "Returns the ARNs that match a pattern, as the IAM ArnLike condition operator does",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "arns",
+				ElementType:         types.StringType,
+				MarkdownDescription: "ARNs (Amazon Resource Names) to filter",
+			},
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, in which `*` matches any sequence of characters and `?` matches any single character within a segment",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f arnLikeFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arns []string
+	var pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arns, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateARNPattern(pattern); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result := make([]string, 0)
+	for _, arn := range arns {
+		if arnLike(arn, pattern) {
+			result = append(result, arn)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
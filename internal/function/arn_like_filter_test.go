@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNLikeFilterFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNLikeFilterFunctionConfig("arn:aws:iam::444455556666:role/*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/example,arn:aws:iam::444455556666:role/path/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFilterFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNLikeFilterFunctionConfig("arn:aws:iam::111122223333:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFilterFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNLikeFilterFunctionConfig("role/*"),
+				ExpectError: expectedErrorInvalidARNPattern,
+			},
+		},
+	})
+}
+
+func testARNLikeFilterFunctionConfig(pattern string) string {
+	return fmt.Sprintf(`
+locals {
+  arns = [
+    "arn:aws:iam::444455556666:role/example",
+    "arn:aws:iam::444455556666:user/example",
+    "arn:aws:iam::444455556666:role/path/example",
+    "arn:aws:s3:::example",
+  ]
+}
+
+output "test" {
+  value = join(",", provider::aws::arn_like_filter(local.arns, %[1]q))
+}
+`, pattern)
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+var (
+	expectedErrorInvalidARNPattern = regexache.MustCompile(`pattern[\s\n]*must[\s\n]*have[\s\n]*6`)
+)
+
+func TestARNLikeFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNLikeFunctionConfig("arn:aws:s3:::logs-prod/AWSLogs/444455556666/CloudTrail/trail.json.gz", "arn:aws:s3:::logs-*/AWSLogs/*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// A wildcard doesn't match across segments, so the empty region segment doesn't match "role".
+				Config: testARNLikeFunctionConfig("arn:aws:iam::444455556666:role/example", "arn:aws:iam:*role:*:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFunction_singleCharacterWildcard(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNLikeFunctionConfig("arn:aws:iam::444455556666:role/app-1", "arn:aws:iam::*:role/app-?"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNLikeFunctionConfig("arn:aws:iam::444455556666:role/example", "arn:aws:*"),
+				ExpectError: expectedErrorInvalidARNPattern,
+			},
+		},
+	})
+}
+
+func testARNLikeFunctionConfig(arn, pattern string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_like(%[1]q, %[2]q)
+}
+`, arn, pattern)
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = ecrImageURIBuildFunction{}
+
+func NewECRImageURIBuildFunction() function.Function {
+	return &ecrImageURIBuildFunction{}
+}
+
+type ecrImageURIBuildFunction struct{}
+
+func (f ecrImageURIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_build"
+}
+
+func (f ecrImageURIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_image_uri_build Function",
+		MarkdownDescription: "Builds an Amazon ECR image URI from its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "registry_id",
+				MarkdownDescription: "AWS account ID of the registry",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code of the registry",
+			},
+			function.StringParameter{
+				Name:                "repository_name",
+				MarkdownDescription: "Name of the repository",
+			},
+			function.StringParameter{
+				Name:                "image_tag",
+				MarkdownDescription: "Image tag. May be empty",
+			},
+			function.StringParameter{
+				Name:                "image_digest",
+				MarkdownDescription: "Image digest, such as sha256:... May be empty",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ecrImageURIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri ecrImageURI
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri.registryID, &uri.region, &uri.repositoryName, &uri.imageTag, &uri.imageDigest))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := uri.validate(); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, uri.String()))
+}
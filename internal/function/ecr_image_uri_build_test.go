@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageURIBuildFunction_tag(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIBuildFunctionConfig("444455556666", "us-west-2", "team/app", "v1.2.3", ""), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "444455556666.dkr.ecr.us-west-2.amazonaws.com/team/app:v1.2.3"), //lintignore:AWSAT003
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_digest(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIBuildFunctionConfig("444455556666", "cn-north-1", "app", "", "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "444455556666.dkr.ecr.cn-north-1.amazonaws.com.cn/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"), //lintignore:AWSAT003
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_invalidRegistryID(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIBuildFunctionConfig("4444", "us-west-2", "app", "latest", ""), //lintignore:AWSAT003
+				ExpectError: regexache.MustCompile(`registry[\s\n]*ID[\s\n]*\(4444\)[\s\n]*must`),
+			},
+		},
+	})
+}
+
+func testECRImageURIBuildFunctionConfig(registryID, region, repositoryName, imageTag, imageDigest string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ecr_image_uri_build(%[1]q, %[2]q, %[3]q, %[4]q, %[5]q)
+}
+`, registryID, region, repositoryName, imageTag, imageDigest)
+}
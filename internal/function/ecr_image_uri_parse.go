@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	ecrImageURIRegistryRegexp   = regexache.MustCompile(`^([0-9]{12})\.dkr\.ecr\.([a-z0-9-]+)\.(.+)$`)
+	ecrImageURIRegistryIDRegexp = regexache.MustCompile(`^[0-9]{12}$`)
+	ecrImageURIRegionRegexp     = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+	ecrImageURIRepositoryRegexp = regexache.MustCompile(`^([a-z0-9]+([._-][a-z0-9]+)*/)*[a-z0-9]+([._-][a-z0-9]+)*$`)
+	ecrImageURITagRegexp        = regexache.MustCompile(`^[0-9A-Za-z_][0-9A-Za-z_.-]{0,127}$`)
+	ecrImageURIDigestRegexp     = regexache.MustCompile(`^[a-z0-9]+([+._-][a-z0-9]+)*:[0-9A-Za-z=_-]+$`)
+)
+
+var ecrImageURIParseResultAttrTypes = map[string]attr.Type{
+	"registry_id":     types.StringType,
+	"region":          types.StringType,
+	"repository_name": types.StringType,
+	"image_tag":       types.StringType,
+	"image_digest":    types.StringType,
+}
+
+var _ function.Function = ecrImageURIParseFunction{}
+
+func NewECRImageURIParseFunction() function.Function {
+	return &ecrImageURIParseFunction{}
+}
+
+type ecrImageURIParseFunction struct{}
+
+func (f ecrImageURIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_parse"
+}
+
+func (f ecrImageURIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_image_uri_parse Function",
+		MarkdownDescription: "Parses an Amazon ECR image URI into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "Amazon ECR image URI, in the form account.dkr.ecr.region.amazonaws.com/repository:tag@digest, to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ecrImageURIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f ecrImageURIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseECRImageURI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"registry_id":     types.StringValue(parts.registryID),
+		"region":          types.StringValue(parts.region),
+		"repository_name": types.StringValue(parts.repositoryName),
+		"image_tag":       types.StringValue(parts.imageTag),
+		"image_digest":    types.StringValue(parts.imageDigest),
+	}
+
+	result, d := types.ObjectValue(ecrImageURIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type ecrImageURI struct {
+	registryID     string
+	region         string
+	repositoryName string
+	imageTag       string
+	imageDigest    string
+}
+
+// parseECRImageURI parses an image URI for a private Amazon ECR repository.
+// The registry's DNS suffix must be that of the Region's partition.
+func parseECRImageURI(uri string) (*ecrImageURI, error) {
+	registry, image, ok := strings.Cut(uri, "/")
+	if !ok {
+		return nil, errors.New("ECR image URI must contain a registry and a repository name separated by /")
+	}
+
+	m := ecrImageURIRegistryRegexp.FindStringSubmatch(registry)
+	if m == nil {
+		return nil, fmt.Errorf("ECR registry (%s) must be in the form account.dkr.ecr.region.dns-suffix", registry)
+	}
+
+	parts := &ecrImageURI{
+		registryID: m[1],
+		region:     m[2],
+	}
+
+	if dnsSuffix := names.PartitionForRegion(parts.region).DNSSuffix(); m[3] != dnsSuffix {
+		return nil, fmt.Errorf("ECR registry (%s) DNS suffix must be %s for Region %s", registry, dnsSuffix, parts.region)
+	}
+
+	image, parts.imageDigest, _ = strings.Cut(image, "@")
+	parts.repositoryName, parts.imageTag, _ = strings.Cut(image, ":")
+
+	if err := parts.validate(); err != nil {
+		return nil, err
+	}
+
+	return parts, nil
+}
+
+func (u ecrImageURI) validate() error {
+	if !ecrImageURIRegistryIDRegexp.MatchString(u.registryID) {
+		return fmt.Errorf("ECR registry ID (%s) must be a 12-digit AWS account ID", u.registryID)
+	}
+
+	if !ecrImageURIRegionRegexp.MatchString(u.region) {
+		return fmt.Errorf("ECR Region (%s) must be a Region code, such as us-west-2", u.region)
+	}
+
+	if !ecrImageURIRepositoryRegexp.MatchString(u.repositoryName) {
+		return fmt.Errorf("ECR repository name (%s) must be lowercase letters and numbers, optionally separated by periods, underscores, hyphens and forward slashes", u.repositoryName)
+	}
+
+	if u.imageTag != "" && !ecrImageURITagRegexp.MatchString(u.imageTag) {
+		return fmt.Errorf("ECR image tag (%s) must be up to 128 letters, numbers, underscores, periods and hyphens, not beginning with a period or hyphen", u.imageTag)
+	}
+
+	if u.imageDigest != "" && !ecrImageURIDigestRegexp.MatchString(u.imageDigest) {
+		return fmt.Errorf("ECR image digest (%s) must be in the form algorithm:hex, such as sha256:...", u.imageDigest)
+	}
+
+	return nil
+}
+
+// String returns the image URI. The registry's DNS suffix is that of the Region's partition.
+func (u ecrImageURI) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s.dkr.ecr.%s.%s/%s", u.registryID, u.region, names.PartitionForRegion(u.region).DNSSuffix(), u.repositoryName)
+	if u.imageTag != "" {
+		sb.WriteString(":" + u.imageTag)
+	}
+	if u.imageDigest != "" {
+		sb.WriteString("@" + u.imageDigest)
+	}
+
+	return sb.String()
+}
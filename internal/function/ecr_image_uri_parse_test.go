@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageURIParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.us-west-2.amazonaws.com/team/app:v1.2.3@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry_id", "444455556666"),
+					resource.TestCheckOutput("region", "us-west-2"), //lintignore:AWSAT003
+					resource.TestCheckOutput("repository_name", "team/app"),
+					resource.TestCheckOutput("image_tag", "v1.2.3"),
+					resource.TestCheckOutput("image_digest", "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_china(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.cn-north-1.amazonaws.com.cn/app"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry_id", "444455556666"),
+					resource.TestCheckOutput("region", "cn-north-1"), //lintignore:AWSAT003
+					resource.TestCheckOutput("repository_name", "app"),
+					resource.TestCheckOutput("image_tag", ""),
+					resource.TestCheckOutput("image_digest", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_wrongPartition(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.cn-north-1.amazonaws.com/app:latest"), //lintignore:AWSAT003
+				ExpectError: regexache.MustCompile(`DNS[\s\n]*suffix[\s\n]*must[\s\n]*be[\s\n]*amazonaws\.com\.cn`),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIParseFunctionConfig("public.ecr.aws/app:latest"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*in[\s\n]*the[\s\n]*form`),
+			},
+		},
+	})
+}
+
+func testECRImageURIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::ecr_image_uri_parse(%[1]q)
+}
+
+output "registry_id" {
+  value = local.result.registry_id
+}
+
+output "region" {
+  value = local.result.region
+}
+
+output "repository_name" {
+  value = local.result.repository_name
+}
+
+output "image_tag" {
+  value = local.result.image_tag
+}
+
+output "image_digest" {
+  value = local.result.image_digest
+}
+`, arg)
+}
@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// iamPolicyDocument is an IAM policy document as accepted by AWS.
+// Elements that may be either a single value or a list are always held as lists.
+type iamPolicyDocument struct {
+	Version   string                 `json:",omitempty"`
+	Id        string                 `json:",omitempty"`
+	Statement iamPolicyStatementList `json:",omitempty"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string                                    `json:",omitempty"`
+	Effect       string                                    `json:",omitempty"`
+	Principal    *iamPolicyPrincipal                       `json:",omitempty"`
+	NotPrincipal *iamPolicyPrincipal                       `json:",omitempty"`
+	Action       iamPolicyStringList                       `json:",omitempty"`
+	NotAction    iamPolicyStringList                       `json:",omitempty"`
+	Resource     iamPolicyStringList                       `json:",omitempty"`
+	NotResource  iamPolicyStringList                       `json:",omitempty"`
+	Condition    map[string]map[string]iamPolicyStringList `json:",omitempty"`
+}
+
+// iamPolicyStatementList is the Statement element, which may be a single statement or a list of statements.
+type iamPolicyStatementList []iamPolicyStatement
+
+func (l *iamPolicyStatementList) UnmarshalJSON(b []byte) error {
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '{' {
+		var statement iamPolicyStatement
+		if err := json.Unmarshal(b, &statement); err != nil {
+			return err
+		}
+
+		*l = iamPolicyStatementList{statement}
+
+		return nil
+	}
+
+	var statements []iamPolicyStatement
+	if err := json.Unmarshal(b, &statements); err != nil {
+		return err
+	}
+
+	*l = statements
+
+	return nil
+}
+
+// iamPolicyPrincipal is the Principal or NotPrincipal element, which is either "*" or a map of principal types to identifiers.
+type iamPolicyPrincipal struct {
+	Wildcard   bool
+	Principals map[string]iamPolicyStringList
+}
+
+func (p *iamPolicyPrincipal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != "*" {
+			return fmt.Errorf("unsupported principal %q", s)
+		}
+
+		*p = iamPolicyPrincipal{Wildcard: true}
+
+		return nil
+	}
+
+	var principals map[string]iamPolicyStringList
+	if err := json.Unmarshal(b, &principals); err != nil {
+		return err
+	}
+
+	*p = iamPolicyPrincipal{Principals: principals}
+
+	return nil
+}
+
+func (p iamPolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if p.Wildcard {
+		return []byte(`"*"`), nil
+	}
+
+	v, err := marshalIAMPolicyJSON(p.Principals)
+
+	return []byte(v), err
+}
+
+// iamPolicyStringList is an element that may be a single value or a list of values.
+// Condition values may be booleans or numbers, which are held as strings.
+// A null element is the same as an absent one. Any other element that is present is never nil, even if it is an empty list.
+type iamPolicyStringList []string
+
+func (l *iamPolicyStringList) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		return nil
+	}
+
+	var raw any
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	values := make(iamPolicyStringList, 0)
+
+	switch raw := raw.(type) {
+	case []any:
+		for _, v := range raw {
+			s, err := iamPolicyScalarString(v)
+			if err != nil {
+				return err
+			}
+			values = append(values, s)
+		}
+	default:
+		s, err := iamPolicyScalarString(raw)
+		if err != nil {
+			return err
+		}
+		values = append(values, s)
+	}
+
+	*l = values
+
+	return nil
+}
+
+// MarshalJSON marshals a single value as a string, as AWS does, and any other number of values as a list.
+func (l iamPolicyStringList) MarshalJSON() ([]byte, error) {
+	var v any = append(make([]string, 0, len(l)), l...)
+	if len(l) == 1 {
+		v = l[0]
+	}
+
+	s, err := marshalIAMPolicyJSON(v)
+
+	return []byte(s), err
+}
+
+func iamPolicyScalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case json.Number:
+		// Numbers are formatted as the provider does when comparing policies, so that 1 and 1.0 are the same value.
+		f, err := v.Float64()
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// parseIAMPolicyDocument parses a JSON IAM policy document.
+// As when the provider compares policies, an empty string is an empty policy
+// and a list containing a single policy is that policy.
+func parseIAMPolicyDocument(s string) (*iamPolicyDocument, error) {
+	var doc iamPolicyDocument
+
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(s, "["), "]"))
+	}
+	if s == "" {
+		s = "{}"
+	}
+
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, err
+	}
+
+	for i := range doc.Statement {
+		statement := &doc.Statement[i]
+
+		switch {
+		case strings.EqualFold(statement.Effect, iamPolicyEffectAllow):
+			statement.Effect = iamPolicyEffectAllow
+		case strings.EqualFold(statement.Effect, iamPolicyEffectDeny):
+			statement.Effect = iamPolicyEffectDeny
+		default:
+			return nil, fmt.Errorf("%s: Effect must be %q or %q", statement.label(i), iamPolicyEffectAllow, iamPolicyEffectDeny)
+		}
+
+		// As when the provider compares policies, an empty list is the same as no list.
+		for _, l := range []*iamPolicyStringList{&statement.Action, &statement.NotAction, &statement.Resource, &statement.NotResource} {
+			if len(*l) == 0 {
+				*l = nil
+			}
+		}
+
+		if (statement.Action == nil) == (statement.NotAction == nil) {
+			return nil, fmt.Errorf("%s: exactly one of Action or NotAction must be set", statement.label(i))
+		}
+
+		if statement.Resource != nil && statement.NotResource != nil {
+			return nil, fmt.Errorf("%s: only one of Resource or NotResource may be set", statement.label(i))
+		}
+	}
+
+	return &doc, nil
+}
+
+const (
+	iamPolicyEffectAllow = "Allow"
+	iamPolicyEffectDeny  = "Deny"
+)
+
+// label identifies the statement by its Sid, or by its position in the policy if it has none.
+func (s iamPolicyStatement) label(i int) string {
+	if s.Sid != "" {
+		return s.Sid
+	}
+
+	return fmt.Sprintf("Statement[%d]", i)
+}
+
+// normalize puts the document into the canonical form in which policies that the provider considers
+// equivalent are identical: the order of statements and of values doesn't matter, a single value is
+// the same as a list containing that value and an empty list is the same as no list.
+func (doc *iamPolicyDocument) normalize() error {
+	type keyedStatement struct {
+		key       string
+		statement iamPolicyStatement
+	}
+
+	statements := make([]keyedStatement, 0, len(doc.Statement))
+	for _, statement := range doc.Statement {
+		statement.normalize()
+
+		key, err := marshalIAMPolicyJSON(statement)
+		if err != nil {
+			return err
+		}
+
+		statements = append(statements, keyedStatement{key: key, statement: statement})
+	}
+
+	slices.SortStableFunc(statements, func(a, b keyedStatement) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	doc.Statement = nil
+	for _, v := range statements {
+		doc.Statement = append(doc.Statement, v.statement)
+	}
+
+	return nil
+}
+
+func (s *iamPolicyStatement) normalize() {
+	s.Principal = s.Principal.normalize()
+	s.NotPrincipal = s.NotPrincipal.normalize()
+	s.Action = s.Action.normalize()
+	s.NotAction = s.NotAction.normalize()
+	s.Resource = s.Resource.normalize()
+	s.NotResource = s.NotResource.normalize()
+
+	if len(s.Condition) == 0 {
+		s.Condition = nil
+	}
+	for _, condition := range s.Condition {
+		for key, values := range condition {
+			// Unlike other elements, an empty list of condition values isn't the same as no list.
+			condition[key] = append(iamPolicyStringList{}, slices.Sorted(slices.Values(values))...)
+		}
+	}
+}
+
+// normalize sorts the principals' identifiers and drops principal types without identifiers.
+// AWS treats an account's root user ARN as the account ID, so the ARN is replaced by the account ID.
+func (p *iamPolicyPrincipal) normalize() *iamPolicyPrincipal {
+	if p == nil || p.Wildcard {
+		return p
+	}
+
+	principals := make(map[string]iamPolicyStringList)
+	for k, v := range p.Principals {
+		v = append(iamPolicyStringList{}, v...)
+		for i, principal := range v {
+			if principalARN, err := arn.Parse(principal); err == nil && principalARN.Service == "iam" && principalARN.Resource == "root" && inttypes.IsAWSAccountID(principalARN.AccountID) {
+				v[i] = principalARN.AccountID
+			}
+		}
+
+		if v = v.normalize(); v != nil {
+			principals[k] = v
+		}
+	}
+
+	if len(principals) == 0 {
+		return nil
+	}
+
+	return &iamPolicyPrincipal{Principals: principals}
+}
+
+// normalize sorts the values. An empty list is nil.
+func (l iamPolicyStringList) normalize() iamPolicyStringList {
+	if len(l) == 0 {
+		return nil
+	}
+
+	return slices.Sorted(slices.Values(l))
+}
+
+// marshalIAMPolicyJSON returns a policy document or statement as compact JSON, without escaping HTML characters.
+func marshalIAMPolicyJSON(v any) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,601 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	iamPolicyDecisionAllow        = "Allow"
+	iamPolicyDecisionExplicitDeny = "ExplicitDeny"
+	iamPolicyDecisionImplicitDeny = "ImplicitDeny"
+)
+
+var iamPolicyEvaluateResultAttrTypes = map[string]attr.Type{
+	"decision":           types.StringType,
+	"matched_statements": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = iamPolicyEvaluateFunction{}
+
+func NewIAMPolicyEvaluateFunction() function.Function {
+	return &iamPolicyEvaluateFunction{}
+}
+
+type iamPolicyEvaluateFunction struct{}
+
+func (f iamPolicyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_evaluate"
+}
+
+func (f iamPolicyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_evaluate Function",
+		MarkdownDescription: "Evaluates whether IAM policy documents allow an action on a resource, without calling AWS",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action to evaluate, such as `s3:GetObject`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource to evaluate",
+			},
+			function.MapParameter{
+				Name:                "context",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "Condition context keys and their values",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f iamPolicyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var action, resource string
+	var requestContext map[string][]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &action, &resource, &requestContext))
+	if resp.Error != nil {
+		return
+	}
+
+	decision, matchedStatements, err := evaluateIAMPolicies(policies, action, resource, requestContext)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	matched, d := types.ListValueFrom(ctx, types.StringType, matchedStatements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"decision":           types.StringValue(decision),
+		"matched_statements": matched,
+	}
+
+	result, d := types.ObjectValue(iamPolicyEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// evaluateIAMPolicies evaluates a request against a set of policies using the IAM policy evaluation logic
+// for a single account: an explicit deny in any policy overrides any allow, and a request that isn't
+// explicitly allowed is implicitly denied.
+// It returns the decision and the statements that determined it.
+// Principal and NotPrincipal elements aren't evaluated.
+func evaluateIAMPolicies(policies []string, action, resource string, requestContext map[string][]string) (string, []string, error) {
+	requestContext = normalizeIAMPolicyContext(requestContext)
+
+	allowed := make([]string, 0)
+	denied := make([]string, 0)
+
+	for i, policy := range policies {
+		doc, err := parseIAMPolicyDocument(policy)
+		if err != nil {
+			return "", nil, fmt.Errorf("policies[%d]: %w", i, err)
+		}
+
+		for j, statement := range doc.Statement {
+			ok, err := statement.applies(action, resource, requestContext)
+			if err != nil {
+				return "", nil, fmt.Errorf("policies[%d]: %s: %w", i, statement.label(j), err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			label := statement.label(j)
+			if statement.Sid == "" {
+				label = fmt.Sprintf("policies[%d].%s", i, label)
+			}
+
+			if statement.Effect == iamPolicyEffectDeny {
+				denied = append(denied, label)
+			} else {
+				allowed = append(allowed, label)
+			}
+		}
+	}
+
+	switch {
+	case len(denied) > 0:
+		return iamPolicyDecisionExplicitDeny, denied, nil
+	case len(allowed) > 0:
+		return iamPolicyDecisionAllow, allowed, nil
+	default:
+		return iamPolicyDecisionImplicitDeny, make([]string, 0), nil
+	}
+}
+
+// normalizeIAMPolicyContext lower-cases context keys, as condition keys aren't case-sensitive.
+func normalizeIAMPolicyContext(requestContext map[string][]string) map[string][]string {
+	normalized := make(map[string][]string, len(requestContext))
+
+	for k, v := range requestContext {
+		k = strings.ToLower(k)
+		normalized[k] = append(normalized[k], v...)
+	}
+
+	return normalized
+}
+
+// applies returns whether the statement applies to the request.
+func (s iamPolicyStatement) applies(action, resource string, requestContext map[string][]string) (bool, error) {
+	if s.Action != nil {
+		if !matchesAnyIAMPolicyAction(s.Action, action) {
+			return false, nil
+		}
+	} else if matchesAnyIAMPolicyAction(s.NotAction, action) {
+		return false, nil
+	}
+
+	if s.Resource != nil {
+		if !matchesAnyIAMPolicyResource(s.Resource, resource, requestContext) {
+			return false, nil
+		}
+	} else if s.NotResource != nil && matchesAnyIAMPolicyResource(s.NotResource, resource, requestContext) {
+		return false, nil
+	}
+
+	// Condition operators are ANDed.
+	for operator, conditions := range s.Condition {
+		for key, values := range conditions {
+			ok, err := evaluateIAMPolicyCondition(operator, key, values, requestContext)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// matchesAnyIAMPolicyAction returns whether the action matches any of the patterns.
+// Actions aren't case-sensitive.
+func matchesAnyIAMPolicyAction(patterns []string, action string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return iamPolicyWildcardMatch(escapeIAMPolicyPattern(strings.ToLower(pattern)), strings.ToLower(action))
+	})
+}
+
+// matchesAnyIAMPolicyResource returns whether the resource matches any of the patterns.
+// Patterns may contain policy variables. A pattern with a variable that can't be resolved doesn't match.
+func matchesAnyIAMPolicyResource(patterns []string, resource string, requestContext map[string][]string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		pattern, ok := expandIAMPolicyVariables(pattern, requestContext)
+
+		return ok && iamPolicyWildcardMatch(pattern, resource)
+	})
+}
+
+// escapeIAMPolicyPattern escapes backslashes so that the pattern can be used with iamPolicyWildcardMatch.
+func escapeIAMPolicyPattern(pattern string) string {
+	return strings.ReplaceAll(pattern, `\`, `\\`)
+}
+
+// escapeIAMPolicyLiteral escapes a value so that iamPolicyWildcardMatch matches it literally.
+func escapeIAMPolicyLiteral(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`).Replace(value)
+}
+
+// expandIAMPolicyVariables replaces policy variables such as ${aws:username} with their values from the request context.
+// The result is escaped for use with iamPolicyWildcardMatch, with substituted values matched literally.
+// A variable may give a default value, as in ${aws:username, 'anonymous'}.
+// It returns false if a variable has no value, or has more than one value, and no default.
+func expandIAMPolicyVariables(pattern string, requestContext map[string][]string) (string, bool) {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(pattern, "${")
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(pattern[start:], "}")
+		if end < 0 {
+			break
+		}
+		end += start
+
+		sb.WriteString(escapeIAMPolicyPattern(pattern[:start]))
+
+		variable := pattern[start+2 : end]
+		switch variable {
+		case "*", "?", "$":
+			sb.WriteString(escapeIAMPolicyLiteral(variable))
+		default:
+			key, defaultValue, hasDefault := strings.Cut(variable, ",")
+			key = strings.ToLower(strings.TrimSpace(key))
+
+			if values := requestContext[key]; len(values) == 1 {
+				sb.WriteString(escapeIAMPolicyLiteral(values[0]))
+			} else if hasDefault {
+				sb.WriteString(escapeIAMPolicyLiteral(strings.Trim(strings.TrimSpace(defaultValue), "'")))
+			} else {
+				return "", false
+			}
+		}
+
+		pattern = pattern[end+1:]
+	}
+
+	sb.WriteString(escapeIAMPolicyPattern(pattern))
+
+	return sb.String(), true
+}
+
+// iamPolicyWildcardMatch returns whether s matches the pattern, in which '*' matches any sequence of characters,
+// '?' matches any single character and '\' matches the following character literally.
+func iamPolicyWildcardMatch(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	pi, ri := 0, 0
+	starPi, starRi := -1, 0
+
+	for ri < len(r) {
+		if pi < len(p) {
+			switch c := p[pi]; {
+			case c == '*':
+				starPi, starRi = pi, ri
+				pi++
+				continue
+			case c == '?':
+				pi++
+				ri++
+				continue
+			case c == '\\' && pi+1 < len(p):
+				if p[pi+1] == r[ri] {
+					pi += 2
+					ri++
+					continue
+				}
+			case c == r[ri]:
+				pi++
+				ri++
+				continue
+			}
+		}
+
+		if starPi < 0 {
+			return false
+		}
+
+		starRi++
+		pi, ri = starPi+1, starRi
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+type iamPolicyConditionOperator struct {
+	// match compares a request context value with a policy value.
+	match func(contextValue, policyValue string) (bool, error)
+	// negated operators match when the request context value matches none of the policy values.
+	negated bool
+	// variables is whether policy values may contain policy variables.
+	variables bool
+}
+
+var iamPolicyConditionOperators = map[string]iamPolicyConditionOperator{
+	"StringEquals":              {match: iamPolicyStringEquals, variables: true},
+	"StringNotEquals":           {match: iamPolicyStringEquals, negated: true, variables: true},
+	"StringEqualsIgnoreCase":    {match: iamPolicyStringEqualsIgnoreCase, variables: true},
+	"StringNotEqualsIgnoreCase": {match: iamPolicyStringEqualsIgnoreCase, negated: true, variables: true},
+	"StringLike":                {match: iamPolicyStringLike, variables: true},
+	"StringNotLike":             {match: iamPolicyStringLike, negated: true, variables: true},
+	"NumericEquals":             {match: iamPolicyNumericCompare(func(c int) bool { return c == 0 })},
+	"NumericNotEquals":          {match: iamPolicyNumericCompare(func(c int) bool { return c == 0 }), negated: true},
+	"NumericLessThan":           {match: iamPolicyNumericCompare(func(c int) bool { return c < 0 })},
+	"NumericLessThanEquals":     {match: iamPolicyNumericCompare(func(c int) bool { return c <= 0 })},
+	"NumericGreaterThan":        {match: iamPolicyNumericCompare(func(c int) bool { return c > 0 })},
+	"NumericGreaterThanEquals":  {match: iamPolicyNumericCompare(func(c int) bool { return c >= 0 })},
+	"DateEquals":                {match: iamPolicyDateCompare(func(c int) bool { return c == 0 })},
+	"DateNotEquals":             {match: iamPolicyDateCompare(func(c int) bool { return c == 0 }), negated: true},
+	"DateLessThan":              {match: iamPolicyDateCompare(func(c int) bool { return c < 0 })},
+	"DateLessThanEquals":        {match: iamPolicyDateCompare(func(c int) bool { return c <= 0 })},
+	"DateGreaterThan":           {match: iamPolicyDateCompare(func(c int) bool { return c > 0 })},
+	"DateGreaterThanEquals":     {match: iamPolicyDateCompare(func(c int) bool { return c >= 0 })},
+	"Bool":                      {match: iamPolicyBool},
+	"BinaryEquals":              {match: iamPolicyBinaryEquals},
+	"IpAddress":                 {match: iamPolicyIPAddress},
+	"NotIpAddress":              {match: iamPolicyIPAddress, negated: true},
+	"ArnEquals":                 {match: iamPolicyARNLike, variables: true},
+	"ArnNotEquals":              {match: iamPolicyARNLike, negated: true, variables: true},
+	"ArnLike":                   {match: iamPolicyARNLike, variables: true},
+	"ArnNotLike":                {match: iamPolicyARNLike, negated: true, variables: true},
+}
+
+const (
+	iamPolicyConditionForAllValues = "ForAllValues:"
+	iamPolicyConditionForAnyValue  = "ForAnyValue:"
+	iamPolicyConditionIfExists     = "IfExists"
+	iamPolicyConditionNull         = "Null"
+)
+
+// evaluateIAMPolicyCondition evaluates a single condition key of a condition operator.
+// The condition matches if the request context value matches any of the policy values.
+func evaluateIAMPolicyCondition(name, key string, policyValues []string, requestContext map[string][]string) (bool, error) {
+	contextValues, present := requestContext[strings.ToLower(key)]
+	present = present && len(contextValues) > 0
+
+	operatorName := name
+	forAllValues, forAnyValue := false, false
+	if v, ok := strings.CutPrefix(operatorName, iamPolicyConditionForAllValues); ok {
+		operatorName, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(operatorName, iamPolicyConditionForAnyValue); ok {
+		operatorName, forAnyValue = v, true
+	}
+
+	if operatorName == iamPolicyConditionNull {
+		for _, v := range policyValues {
+			switch strings.ToLower(v) {
+			case "true":
+				if !present {
+					return true, nil
+				}
+			case "false":
+				if present {
+					return true, nil
+				}
+			default:
+				return false, fmt.Errorf("condition %s: value %q must be true or false", name, v)
+			}
+		}
+
+		return false, nil
+	}
+
+	operatorName, ifExists := strings.CutSuffix(operatorName, iamPolicyConditionIfExists)
+
+	operator, ok := iamPolicyConditionOperators[operatorName]
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator %q", name)
+	}
+
+	if !present {
+		switch {
+		case ifExists, forAllValues:
+			return true, nil
+		case forAnyValue:
+			return false, nil
+		default:
+			return operator.negated, nil
+		}
+	}
+
+	// matches returns whether a request context value matches the condition.
+	matches := func(contextValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			if operator.variables {
+				var ok bool
+				if policyValue, ok = expandIAMPolicyVariables(policyValue, requestContext); !ok {
+					continue
+				}
+			}
+
+			ok, err := operator.match(contextValue, policyValue)
+			if err != nil {
+				return false, fmt.Errorf("condition %s: %w", name, err)
+			}
+
+			if ok {
+				return !operator.negated, nil
+			}
+		}
+
+		return operator.negated, nil
+	}
+
+	switch {
+	case forAllValues:
+		for _, v := range contextValues {
+			if ok, err := matches(v); err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	case forAnyValue, !operator.negated:
+		for _, v := range contextValues {
+			if ok, err := matches(v); err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+	default:
+		// A negated operator on a multivalued key matches only if no value matches a policy value.
+		for _, v := range contextValues {
+			if ok, err := matches(v); err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// Policy values passed to the string and ARN functions have had policy variables expanded
+// and are escaped for use with iamPolicyWildcardMatch.
+
+func iamPolicyStringEquals(contextValue, policyValue string) (bool, error) {
+	return unescapeIAMPolicyPattern(policyValue) == contextValue, nil
+}
+
+func iamPolicyStringEqualsIgnoreCase(contextValue, policyValue string) (bool, error) {
+	return strings.EqualFold(unescapeIAMPolicyPattern(policyValue), contextValue), nil
+}
+
+func iamPolicyStringLike(contextValue, policyValue string) (bool, error) {
+	return iamPolicyWildcardMatch(policyValue, contextValue), nil
+}
+
+// iamPolicyARNLike matches each of the six colon-delimited components of an ARN separately,
+// so that a wildcard doesn't match across components.
+func iamPolicyARNLike(contextValue, policyValue string) (bool, error) {
+	const components = 6
+
+	contextParts := strings.SplitN(contextValue, ":", components)
+	policyParts := strings.SplitN(policyValue, ":", components)
+
+	if len(contextParts) != len(policyParts) {
+		return false, nil
+	}
+
+	for i := range policyParts {
+		if !iamPolicyWildcardMatch(policyParts[i], contextParts[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func iamPolicyBool(contextValue, policyValue string) (bool, error) {
+	return strings.EqualFold(contextValue, policyValue), nil
+}
+
+func iamPolicyBinaryEquals(contextValue, policyValue string) (bool, error) {
+	return contextValue == policyValue, nil
+}
+
+func iamPolicyIPAddress(contextValue, policyValue string) (bool, error) {
+	addr, err := netip.ParseAddr(contextValue)
+	if err != nil {
+		return false, fmt.Errorf("context value %q is not an IP address", contextValue)
+	}
+
+	prefix, err := netip.ParsePrefix(policyValue)
+	if err != nil {
+		v, err := netip.ParseAddr(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("policy value %q is not an IP address or CIDR block", policyValue)
+		}
+		prefix = netip.PrefixFrom(v, v.BitLen())
+	}
+
+	return prefix.Contains(addr), nil
+}
+
+func iamPolicyNumericCompare(f func(int) bool) func(string, string) (bool, error) {
+	return func(contextValue, policyValue string) (bool, error) {
+		c, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("context value %q is not a number", contextValue)
+		}
+
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("policy value %q is not a number", policyValue)
+		}
+
+		switch {
+		case c < p:
+			return f(-1), nil
+		case c > p:
+			return f(1), nil
+		default:
+			return f(0), nil
+		}
+	}
+}
+
+func iamPolicyDateCompare(f func(int) bool) func(string, string) (bool, error) {
+	return func(contextValue, policyValue string) (bool, error) {
+		c, err := parseIAMPolicyDate(contextValue)
+		if err != nil {
+			return false, fmt.Errorf("context value %q is not a date", contextValue)
+		}
+
+		p, err := parseIAMPolicyDate(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("policy value %q is not a date", policyValue)
+		}
+
+		return f(c.Compare(p)), nil
+	}
+}
+
+// parseIAMPolicyDate parses an ISO 8601 date or date and time, or a Unix epoch time in seconds.
+func parseIAMPolicyDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", time.DateOnly} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("parsing date %q", s)
+}
+
+func unescapeIAMPolicyPattern(pattern string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '\\' && i+1 < len(pattern) {
+			i++
+		}
+		sb.WriteByte(pattern[i])
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,547 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestEvaluateIAMPolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		allow        = "Allow"
+		explicitDeny = "ExplicitDeny"
+		implicitDeny = "ImplicitDeny"
+	)
+
+	testCases := map[string]struct {
+		statements         []string
+		action             string
+		resource           string
+		requestContext     map[string][]string
+		expectedDecision   string
+		expectedStatements []string
+		expectError        bool
+	}{
+		"explicit deny overrides allow": {
+			statements: []string{
+				`{"Sid":"AllowAll","Effect":"Allow","Action":"*","Resource":"*"}`,
+				`{"Sid":"DenyDelete","Effect":"Deny","Action":"s3:Delete*","Resource":"*"}`,
+			},
+			action:             "s3:DeleteObject",
+			resource:           "arn:aws:s3:::example/key",
+			expectedDecision:   explicitDeny,
+			expectedStatements: []string{"DenyDelete"},
+		},
+		"no matching statement": {
+			statements:         []string{`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`},
+			action:             "s3:PutObject",
+			resource:           "arn:aws:s3:::example/key",
+			expectedDecision:   implicitDeny,
+			expectedStatements: []string{},
+		},
+		"unlabeled statement": {
+			statements:         []string{`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`},
+			action:             "s3:GetObject",
+			resource:           "arn:aws:s3:::example/key",
+			expectedDecision:   allow,
+			expectedStatements: []string{"policies[0].Statement[0]"},
+		},
+		"NotAction matching": {
+			statements:       []string{`{"Effect":"Allow","NotAction":"IAM:*","Resource":"*"}`},
+			action:           "iam:CreateUser",
+			resource:         "*",
+			expectedDecision: implicitDeny,
+		},
+		"NotAction not matching": {
+			statements:       []string{`{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			expectedDecision: allow,
+		},
+		"NotResource matching": {
+			statements: []string{
+				`{"Effect":"Allow","Action":"s3:*","Resource":"*"}`,
+				`{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::example/*"}`,
+			},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			expectedDecision: allow,
+		},
+		"NotResource not matching": {
+			statements: []string{
+				`{"Effect":"Allow","Action":"s3:*","Resource":"*"}`,
+				`{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::example/*"}`,
+			},
+			action:             "s3:GetObject",
+			resource:           "arn:aws:s3:::other/key",
+			expectedDecision:   explicitDeny,
+			expectedStatements: []string{"policies[0].Statement[1]"},
+		},
+		"ForAllValues all values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["env","team"]}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"env", "team"}},
+			expectedDecision: allow,
+		},
+		"ForAllValues some values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["env","team"]}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"env", "owner"}},
+			expectedDecision: implicitDeny,
+		},
+		"ForAllValues key not present": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["env","team"]}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			expectedDecision: allow,
+		},
+		"ForAnyValue some values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":"env"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"team", "env"}},
+			expectedDecision: allow,
+		},
+		"ForAnyValue no values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":"env"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"team"}},
+			expectedDecision: implicitDeny,
+		},
+		"ForAnyValue key not present": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":"env"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			expectedDecision: implicitDeny,
+		},
+		"IfExists key present and matching": {
+			statements:       []string{`{"Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`},
+			action:           "ec2:RunInstances",
+			resource:         "*",
+			requestContext:   map[string][]string{"ec2:InstanceType": {"t3.micro"}},
+			expectedDecision: allow,
+		},
+		"IfExists key present and not matching": {
+			statements:       []string{`{"Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`},
+			action:           "ec2:RunInstances",
+			resource:         "*",
+			requestContext:   map[string][]string{"ec2:InstanceType": {"m5.large"}},
+			expectedDecision: implicitDeny,
+		},
+		"IfExists key not present": {
+			statements:       []string{`{"Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`},
+			action:           "ec2:RunInstances",
+			resource:         "*",
+			expectedDecision: allow,
+		},
+		"Null true key not present": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Null":{"aws:TokenIssueTime":"true"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			expectedDecision: allow,
+		},
+		"Null true key present": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Null":{"aws:TokenIssueTime":"true"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TokenIssueTime": {"2025-01-01T00:00:00Z"}},
+			expectedDecision: implicitDeny,
+		},
+		"Null false key present": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Null":{"aws:TokenIssueTime":false}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TokenIssueTime": {"2025-01-01T00:00:00Z"}},
+			expectedDecision: allow,
+		},
+		"Null invalid value": {
+			statements:  []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Null":{"aws:TokenIssueTime":"maybe"}}}`},
+			action:      "s3:GetObject",
+			resource:    "*",
+			expectError: true,
+		},
+		"negated operator multivalued key no values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:TagKeys":"secret"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"env", "team"}},
+			expectedDecision: allow,
+		},
+		"negated operator multivalued key some values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:TagKeys":"secret"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"env", "secret"}},
+			expectedDecision: implicitDeny,
+		},
+		"negated operator key not present": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:TagKeys":"secret"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			expectedDecision: allow,
+		},
+		"ForAnyValue negated operator some values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringNotEquals":{"aws:TagKeys":"secret"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"env", "secret"}},
+			expectedDecision: allow,
+		},
+		"ForAllValues negated operator some values match": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringNotLike":{"aws:TagKeys":"secret*"}}}`},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:TagKeys": {"env", "secret-key"}},
+			expectedDecision: implicitDeny,
+		},
+		"policy variable": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username}/*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::home/alice/key",
+			requestContext:   map[string][]string{"aws:username": {"alice"}},
+			expectedDecision: allow,
+		},
+		"policy variable different value": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username}/*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::home/bob/key",
+			requestContext:   map[string][]string{"aws:username": {"alice"}},
+			expectedDecision: implicitDeny,
+		},
+		"policy variable without value": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username}/*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::home/alice/key",
+			expectedDecision: implicitDeny,
+		},
+		"policy variable with default with value": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username, 'anonymous'}/*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::home/alice/key",
+			requestContext:   map[string][]string{"aws:username": {"alice"}},
+			expectedDecision: allow,
+		},
+		"policy variable with default without value": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username, 'anonymous'}/*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::home/anonymous/key",
+			expectedDecision: allow,
+		},
+		"policy variable value matched literally": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username}/*"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::home/alice/key",
+			requestContext:   map[string][]string{"aws:username": {"*"}},
+			expectedDecision: implicitDeny,
+		},
+		"policy variable in condition": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":{"s3:prefix":"home/${aws:username, 'anonymous'}/*"}}}`},
+			action:           "s3:ListBucket",
+			resource:         "arn:aws:s3:::example",
+			requestContext:   map[string][]string{"s3:prefix": {"home/anonymous/docs"}},
+			expectedDecision: allow,
+		},
+		"escaped asterisk matching": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::example/${*}"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/*",
+			expectedDecision: allow,
+		},
+		"escaped asterisk not matching": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::example/${*}"}`},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			expectedDecision: implicitDeny,
+		},
+		"escaped question mark matching": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":{"s3:prefix":"docs${?}"}}}`},
+			action:           "s3:ListBucket",
+			resource:         "arn:aws:s3:::example",
+			requestContext:   map[string][]string{"s3:prefix": {"docs?"}},
+			expectedDecision: allow,
+		},
+		"escaped question mark not matching": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":{"s3:prefix":"docs${?}"}}}`},
+			action:           "s3:ListBucket",
+			resource:         "arn:aws:s3:::example",
+			requestContext:   map[string][]string{"s3:prefix": {"docs1"}},
+			expectedDecision: implicitDeny,
+		},
+		"IpAddress in range": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["203.0.113.0/24","2001:db8::1"]}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:SourceIp": {"203.0.113.5"}},
+			expectedDecision: allow,
+		},
+		"IpAddress single IPv6 address": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["203.0.113.0/24","2001:db8::1"]}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:SourceIp": {"2001:db8::1"}},
+			expectedDecision: allow,
+		},
+		"IpAddress out of range": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"203.0.113.0/24"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:SourceIp": {"198.51.100.1"}},
+			expectedDecision: implicitDeny,
+		},
+		"NotIpAddress out of range": {
+			statements:       []string{`{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"203.0.113.0/24"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:SourceIp": {"198.51.100.1"}},
+			expectedDecision: explicitDeny,
+		},
+		"IpAddress invalid context value": {
+			statements:     []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"203.0.113.0/24"}}}`},
+			action:         "s3:GetObject",
+			resource:       "*",
+			requestContext: map[string][]string{"aws:SourceIp": {"localhost"}},
+			expectError:    true,
+		},
+		"NumericLessThanEquals equal": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":"10"}}}`},
+			action:           "s3:ListBucket",
+			resource:         "*",
+			requestContext:   map[string][]string{"s3:max-keys": {"10"}},
+			expectedDecision: allow,
+		},
+		"NumericLessThanEquals greater": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":"10"}}}`},
+			action:           "s3:ListBucket",
+			resource:         "*",
+			requestContext:   map[string][]string{"s3:max-keys": {"11"}},
+			expectedDecision: implicitDeny,
+		},
+		"NumericGreaterThan number value": {
+			statements:       []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericGreaterThan":{"s3:max-keys":5}}}`},
+			action:           "s3:ListBucket",
+			resource:         "*",
+			requestContext:   map[string][]string{"s3:max-keys": {"5.5"}},
+			expectedDecision: allow,
+		},
+		"NumericEquals invalid context value": {
+			statements:     []string{`{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericEquals":{"s3:max-keys":"10"}}}`},
+			action:         "s3:ListBucket",
+			resource:       "*",
+			requestContext: map[string][]string{"s3:max-keys": {"ten"}},
+			expectError:    true,
+		},
+		"DateLessThan before": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateLessThan":{"aws:CurrentTime":"2025-01-01T00:00:00Z"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:CurrentTime": {"2024-12-31T23:59:59Z"}},
+			expectedDecision: allow,
+		},
+		"DateLessThan equal": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateLessThan":{"aws:CurrentTime":"2025-01-01T00:00:00Z"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:CurrentTime": {"2025-01-01T00:00:00Z"}},
+			expectedDecision: implicitDeny,
+		},
+		"DateGreaterThanEquals epoch time": {
+			statements:       []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateGreaterThanEquals":{"aws:CurrentTime":"1735689600"}}}`},
+			action:           "s3:GetObject",
+			resource:         "*",
+			requestContext:   map[string][]string{"aws:CurrentTime": {"2025-01-01"}},
+			expectedDecision: allow,
+		},
+		"DateEquals invalid policy value": {
+			statements:     []string{`{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateEquals":{"aws:CurrentTime":"yesterday"}}}`},
+			action:         "s3:GetObject",
+			resource:       "*",
+			requestContext: map[string][]string{"aws:CurrentTime": {"2025-01-01"}},
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s]}`, strings.Join(testCase.statements, ","))
+			decision, statements, err := tffunction.EvaluateIAMPolicies([]string{policy}, testCase.action, testCase.resource, testCase.requestContext)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := decision, testCase.expectedDecision; got != want {
+				t.Errorf("decision = %q, want %q", got, want)
+			}
+
+			if testCase.expectedStatements != nil {
+				if diff := cmp.Diff(statements, testCase.expectedStatements); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestIAMPolicyEvaluateFunction_allow(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key", `{ "aws:SecureTransport" = ["true"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "Allow"),
+					resource.TestCheckOutput("matched_statements", "AllowRead"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_explicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key", `{ "aws:SecureTransport" = ["false"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "ExplicitDeny"),
+					resource.TestCheckOutput("matched_statements", "DenyInsecureTransport"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_implicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:*", "*", `{}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "ImplicitDeny"),
+					resource.TestCheckOutput("matched_statements", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEvaluateFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*condition[\s\n]*operator`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEvaluateFunctionConfig(action, resource, context string) string {
+	return fmt.Sprintf(`
+locals {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid       = "AllowRead"
+        Effect    = "Allow"
+        Principal = "*"
+        Action    = ["s3:GetObject", "s3:ListBucket"]
+        Resource  = ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+      },
+      {
+        Sid       = "DenyInsecureTransport"
+        Effect    = "Deny"
+        Principal = "*"
+        Action    = "s3:*"
+        Resource  = ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+        Condition = {
+          Bool = {
+            "aws:SecureTransport" = "false"
+          }
+        }
+      },
+    ]
+  })
+
+  result = provider::aws::iam_policy_evaluate([local.policy], %[1]q, %[2]q, %[3]s)
+}
+
+output "decision" {
+  value = local.result.decision
+}
+
+output "matched_statements" {
+  value = join(",", local.result.matched_statements)
+}
+`, action, resource, context)
+}
+
+func testIAMPolicyEvaluateFunctionConfig_invalid() string {
+	return `
+locals {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+        Condition = {
+          StringMatches = {
+            "aws:username" = "example"
+          }
+        }
+      },
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_evaluate([local.policy], "s3:GetObject", "arn:aws:s3:::example/key", {})
+}
+`
+}
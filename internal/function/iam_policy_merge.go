@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_merge Function",
+		MarkdownDescription: "Merges the statements of IAM policy documents into a single policy document in canonical form",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergeIAMPolicies(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergeIAMPolicies merges the statements of JSON IAM policy documents and returns the canonical form of the result.
+// The merged document has the latest Version and the last Id of the documents.
+// Statements that are equivalent are merged into one. Statements with the same Sid must be equivalent.
+func mergeIAMPolicies(policies []string) (string, error) {
+	var merged iamPolicyDocument
+
+	// Policy index and canonical form of each statement with a Sid.
+	type source struct {
+		index     int
+		statement string
+	}
+	sids := make(map[string]source)
+	seen := make(map[string]struct{})
+
+	for i, policy := range policies {
+		doc, err := parseIAMPolicyDocument(policy)
+		if err != nil {
+			return "", fmt.Errorf("policies[%d]: %w", i, err)
+		}
+
+		if doc.Version > merged.Version {
+			merged.Version = doc.Version
+		}
+
+		if doc.Id != "" {
+			merged.Id = doc.Id
+		}
+
+		for _, statement := range doc.Statement {
+			statement.normalize()
+
+			key, err := marshalIAMPolicyJSON(statement)
+			if err != nil {
+				return "", fmt.Errorf("policies[%d]: %w", i, err)
+			}
+
+			if sid := statement.Sid; sid != "" {
+				if v, ok := sids[sid]; ok && v.statement != key {
+					return "", fmt.Errorf("duplicate Sid (%s) in policies[%d] and policies[%d] with different statements. Remove the Sid or ensure Sids are unique", sid, v.index, i)
+				}
+				sids[sid] = source{index: i, statement: key}
+			}
+
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			merged.Statement = append(merged.Statement, statement)
+		}
+	}
+
+	if err := merged.normalize(); err != nil {
+		return "", err
+	}
+
+	return marshalIAMPolicyJSON(merged)
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig("s3:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"AllowRead","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"},{"Sid":"AllowWrite","Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::example/*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig("s3:ListBucket"),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid[\s\n]*\(AllowRead\)`),
+			},
+		},
+	})
+}
+
+// testIAMPolicyMergeFunctionConfig merges two policies that both contain an AllowRead statement,
+// with the second allowing the given action.
+func testIAMPolicyMergeFunctionConfig(action string) string {
+	return fmt.Sprintf(`
+locals {
+  read = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "arn:aws:s3:::example/*"
+      },
+    ]
+  })
+
+  write = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "AllowWrite"
+        Effect   = "Allow"
+        Action   = ["s3:PutObject"]
+        Resource = ["arn:aws:s3:::example/*"]
+      },
+      {
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = [%[1]q]
+        Resource = ["arn:aws:s3:::example/*"]
+      },
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_merge([local.read, local.write])
+}
+`, action)
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_normalize Function",
+		MarkdownDescription: "Returns the canonical form of an IAM policy document, in which equivalent policies are identical",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizeIAMPolicy(policy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizeIAMPolicy returns the canonical form of a JSON IAM policy document.
+func normalizeIAMPolicy(policy string) (string, error) {
+	doc, err := parseIAMPolicyDocument(policy)
+	if err != nil {
+		return "", err
+	}
+
+	if err := doc.normalize(); err != nil {
+		return "", err
+	}
+
+	return marshalIAMPolicyJSON(doc)
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestIAMPolicyNormalizeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":["arn:aws:s3:::example","arn:aws:s3:::example/*"],"Condition":{"Bool":{"aws:SecureTransport":"false"}}},{"Sid":"AllowRead","Effect":"Allow","Principal":{"AWS":["111122223333","444455556666"]},"Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::example/*"}]}`),
+					resource.TestCheckOutput("equal", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`Effect[\s\n]*must[\s\n]*be`),
+			},
+		},
+	})
+}
+
+// TestNormalizeIAMPolicy checks that policies normalize identically exactly when the provider considers them equivalent.
+func TestNormalizeIAMPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1    string
+		policy2    string
+		equivalent bool
+	}{
+		"statement order": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"single statement": {
+			policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"policy in a list": {
+			policy1:    `[{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}]`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"value order and single value": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["*"]}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"effect case": {
+			policy1:    `{"Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"null element": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotAction":null,"Resource":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"empty list element": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","NotResource":[]}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"account ID principal": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"account ID principals in another partition": {
+			policy1:    `{"Statement":[{"Effect":"Allow","NotPrincipal":{"AWS":["arn:aws-us-gov:iam::123456789012:root","210987654321"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","NotPrincipal":{"AWS":["arn:aws-us-gov:iam::210987654321:root","123456789012"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"principal type without identifiers": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012","Service":[]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"condition values": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true},"NumericLessThan":{"s3:max-keys":[10.0]}}}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"NumericLessThan":{"s3:max-keys":"10"}}}]}`,
+			equivalent: true,
+		},
+		"different actions": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"different Sid": {
+			policy1: `{"Statement":[{"Sid":"a","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Sid":"b","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"wildcard principal": {
+			policy1: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"IAM user principal": {
+			policy1: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:user/root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"empty condition values": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":[]}}}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := verify.PolicyStringsEquivalent(testCase.policy1, testCase.policy2), testCase.equivalent; got != want {
+				t.Fatalf("PolicyStringsEquivalent = %t, want %t", got, want)
+			}
+
+			normalized1, err := tffunction.NormalizeIAMPolicy(testCase.policy1)
+			if err != nil {
+				t.Fatalf("normalizing policy 1: %s", err)
+			}
+			normalized2, err := tffunction.NormalizeIAMPolicy(testCase.policy2)
+			if err != nil {
+				t.Fatalf("normalizing policy 2: %s", err)
+			}
+
+			if got, want := normalized1 == normalized2, testCase.equivalent; got != want {
+				t.Errorf("normalized policies identical = %t, want %t\n%s\n%s", got, want, normalized1, normalized2)
+			}
+		})
+	}
+}
+
+func testIAMPolicyNormalizeFunctionConfig_basic() string {
+	return `
+locals {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "AllowRead"
+        Effect = "Allow"
+        Principal = {
+          AWS = ["arn:aws:iam::444455556666:root", "arn:aws:iam::111122223333:root"]
+        }
+        Action   = ["s3:ListBucket", "s3:GetObject"]
+        Resource = ["arn:aws:s3:::example/*"]
+      },
+      {
+        Effect    = "Deny"
+        Principal = "*"
+        Action    = ["s3:*"]
+        Resource  = ["arn:aws:s3:::example/*", "arn:aws:s3:::example"]
+        Condition = {
+          Bool = {
+            "aws:SecureTransport" = false
+          }
+        }
+      },
+    ]
+  })
+
+  reordered = jsonencode({
+    Statement = [
+      {
+        Condition = {
+          Bool = {
+            "aws:SecureTransport" = "false"
+          }
+        }
+        Resource  = ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+        Action    = "s3:*"
+        Principal = "*"
+        Effect    = "Deny"
+      },
+      {
+        Resource = "arn:aws:s3:::example/*"
+        Action   = ["s3:GetObject", "s3:ListBucket"]
+        Principal = {
+          AWS = ["arn:aws:iam::111122223333:root", "arn:aws:iam::444455556666:root"]
+        }
+        Effect = "Allow"
+        Sid    = "AllowRead"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_normalize(local.policy)
+}
+
+output "equal" {
+  value = provider::aws::iam_policy_normalize(local.policy) == provider::aws::iam_policy_normalize(local.reordered)
+}
+`
+}
+
+func testIAMPolicyNormalizeFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_normalize(jsonencode({
+    Statement = [
+      {
+        Effect   = "Permit"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      },
+    ]
+  }))
+}
+`
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIBuildFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example-bucket", "path/to/object.json"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://example-bucket/path/to/object.json"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_emptyKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("example-bucket", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://example-bucket"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalidBucket(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig("Example_Bucket", "key"),
+				ExpectError: regexache.MustCompile(`bucket[\s\n]*name[\s\n]*must`),
+			},
+		},
+	})
+}
+
+func testS3URIBuildFunctionConfig(bucket, key string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_uri_build(%[1]q, %[2]q)
+}
+`, bucket, key)
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	s3URIScheme = "s3://"
+)
+
+var s3URIBucketRegexp = regexache.MustCompile(`^[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9]$`)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket": types.StringType,
+	"key":    types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI, in the form s3://bucket/key, to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	bucket, key, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket": types.StringValue(bucket),
+		"key":    types.StringValue(key),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseS3URI returns the bucket and key of an S3 URI. The key is empty if the URI has none.
+func parseS3URI(uri string) (string, string, error) {
+	v, ok := strings.CutPrefix(uri, s3URIScheme)
+	if !ok {
+		return "", "", errors.New("S3 URI must start with " + s3URIScheme)
+	}
+
+	bucket, key, _ := strings.Cut(v, "/")
+	if err := validateS3URIBucket(bucket); err != nil {
+		return "", "", err
+	}
+
+	return bucket, key, nil
+}
+
+func validateS3URIBucket(bucket string) error {
+	if !s3URIBucketRegexp.MatchString(bucket) {
+		return errors.New("S3 bucket name must be 3 to 63 lowercase letters, numbers, periods and hyphens, beginning and ending with a letter or number")
+	}
+
+	return nil
+}
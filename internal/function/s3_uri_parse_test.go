@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestS3URIParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example-bucket/path/to/object.json"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "example-bucket"),
+					resource.TestCheckOutput(names.AttrKey, "path/to/object.json"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_bucketOnly(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example-bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput(names.AttrBucket, "example-bucket"),
+					resource.TestCheckOutput(names.AttrKey, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example-bucket.s3.amazonaws.com/key"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*start[\s\n]*with[\s\n]*s3://`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "key" {
+  value = local.result.key
+}
+`, arg)
+}
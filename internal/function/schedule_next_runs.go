@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Time zones must be available wherever the provider runs.
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+const (
+	scheduleNextRunsMaxCount = 1000
+)
+
+var _ function.Function = scheduleNextRunsFunction{}
+
+func NewScheduleNextRunsFunction() function.Function {
+	return &scheduleNextRunsFunction{}
+}
+
+type scheduleNextRunsFunction struct{}
+
+func (f scheduleNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next_runs"
+}
+
+func (f scheduleNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "schedule_next_runs Function",
+		MarkdownDescription: "Returns the times at which an AWS schedule expression next fires",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression, such as `cron(0 9 ? * MON-FRI *)`, `rate(5 minutes)` or `at(2026-10-17T09:30:00)`",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which the expression is evaluated, such as `Europe/London`. An empty string is UTC",
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC 3339 timestamp after which to return run times",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of run times to return, between 1 and %d", scheduleNextRunsMaxCount),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone, start string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &start, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := schedule.Parse(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	// "Local" would make the result depend on the machine running Terraform.
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "Local" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("unknown time zone %q", timezone)))
+		return
+	}
+
+	after, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("start must be an RFC 3339 timestamp: %s", err)))
+		return
+	}
+
+	if count < 1 || count > scheduleNextRunsMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, fmt.Sprintf("count must be between 1 and %d", scheduleNextRunsMaxCount)))
+		return
+	}
+
+	result := make([]string, 0, count)
+	for range count {
+		next, ok := expr.Next(after, loc)
+		if !ok {
+			break
+		}
+
+		result = append(result, next.Format(time.RFC3339))
+		after = next
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
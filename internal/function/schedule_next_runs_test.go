@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleNextRunsFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("cron(0 9 ? * MON-FRI *)", "Europe/London", "2026-10-23T12:00:00Z", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-10-26T09:00:00Z,2026-10-27T09:00:00Z,2026-10-28T09:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("rate(12 hours)", "", "2026-10-17T00:00:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-10-17T12:00:00Z,2026-10-18T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_at(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("at(2026-10-17T09:30:00)", "America/New_York", "2026-10-01T00:00:00Z", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-10-17T09:30:00-04:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_invalidExpression(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextRunsFunctionConfig("cron(0 9 * * MON-FRI *)", "", "2026-10-17T00:00:00Z", 1),
+				ExpectError: regexache.MustCompile(`one[\s\n]*of[\s\n]*the[\s\n]*Day-of-month[\s\n]*and[\s\n]*Day-of-week[\s\n]*fields[\s\n]*must[\s\n]*be[\s\n]*\?`),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_invalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextRunsFunctionConfig("rate(1 day)", "Mars/Olympus_Mons", "2026-10-17T00:00:00Z", 1),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*time[\s\n]*zone`),
+			},
+		},
+	})
+}
+
+func testScheduleNextRunsFunctionConfig(expression, timezone, start string, count int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next_runs(%[1]q, %[2]q, %[3]q, %[4]d))
+}
+`, expression, timezone, start, count)
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = servicePrincipalFunction{}
+
+func NewServicePrincipalFunction() function.Function {
+	return &servicePrincipalFunction{}
+}
+
+type servicePrincipalFunction struct{}
+
+func (f servicePrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_principal"
+}
+
+func (f servicePrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "service_principal Function",
+		MarkdownDescription: "Returns the service principal for a service in the partition of a Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service_name",
+				MarkdownDescription: "Name of the service, such as `logs`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f servicePrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serviceName, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &serviceName, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if serviceName == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "service_name must be set"))
+		return
+	}
+
+	if region == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "region must be set"))
+		return
+	}
+
+	result := serviceName + "." + names.ServicePrincipalSuffixForPartition(serviceName, names.PartitionForRegion(region))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
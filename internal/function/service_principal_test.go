@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestServicePrincipalFunction_standard(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServicePrincipalFunctionConfig("logs", "us-west-2"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "logs.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServicePrincipalFunction_partitionSuffix(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServicePrincipalFunctionConfig("logs", "cn-north-1"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "logs.amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestServicePrincipalFunction_emptyServiceName(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServicePrincipalFunctionConfig("", "us-west-2"), //lintignore:AWSAT003
+				ExpectError: regexache.MustCompile(`service_name[\s\n]*must[\s\n]*be[\s\n]*set`),
+			},
+		},
+	})
+}
+
+func testServicePrincipalFunctionConfig(serviceName, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::service_principal(%[1]q, %[2]q)
+}
+`, serviceName, region)
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type listResourceInjectResourceFilterBlockInterceptor struct {
+	tags    bool
+	regions bool
+}
+
+func (r listResourceInjectResourceFilterBlockInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks[listresourceattribute.ResourceFilterBlockName]; !ok {
+			if response.Schema.Blocks == nil {
+				// Initialize the blocks map if a custom ConfigSchema method has omitted it
+				response.Schema.Blocks = map[string]listschema.Block{}
+			}
+			// Inject a top-level "resource_filter" block.
+			response.Schema.Blocks[listresourceattribute.ResourceFilterBlockName] = listresourceattribute.ResourceFilter(r.tags, r.regions)
+		}
+	}
+}
+
+// listResourceInjectResourceFilterBlock injects the shared "resource_filter" block into a resource's List schema.
+func listResourceInjectResourceFilterBlock(tags, regions bool) listResourceSchemaInterceptor {
+	return &listResourceInjectResourceFilterBlockInterceptor{
+		tags:    tags,
+		regions: regions,
+	}
+}
+
+// expandListResourceFilter reads the "resource_filter" block from a List configuration.
+func expandListResourceFilter(ctx context.Context, config tfsdk.Config) (*listresource.Filter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter listresource.Filter
+
+	if config.Schema == nil || !config.Raw.IsKnown() || config.Raw.IsNull() {
+		return &filter, diags
+	}
+	if _, ok := config.Schema.GetBlocks()[listresourceattribute.ResourceFilterBlockName]; !ok {
+		return &filter, diags
+	}
+
+	var block types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root(listresourceattribute.ResourceFilterBlockName), &block)...)
+	if diags.HasError() || block.IsNull() {
+		return &filter, diags
+	}
+
+	attributes := block.Attributes()
+	if v, ok := attributes[listresourceattribute.ResourceFilterAttrNamePrefix].(types.String); ok {
+		filter.NamePrefix = v.ValueString()
+	}
+	if v, ok := attributes[listresourceattribute.ResourceFilterAttrRegions].(types.List); ok {
+		filter.Regions = fwflex.ExpandFrameworkStringValueList(ctx, v)
+	}
+	if v, ok := attributes[listresourceattribute.ResourceFilterAttrTagKeys].(types.List); ok {
+		filter.TagKeys = fwflex.ExpandFrameworkStringValueList(ctx, v)
+	}
+	if v, ok := attributes[listresourceattribute.ResourceFilterAttrTags].(types.Map); ok {
+		filter.Tags = fwflex.ExpandFrameworkStringValueMap(ctx, v)
+	}
+
+	return &filter, diags
+}
+
+// removeListResourceFilter returns a List configuration without the "resource_filter" block,
+// so that List handlers can read their configuration into models which don't declare it.
+func removeListResourceFilter(ctx context.Context, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schema, ok := config.Schema.(listschema.Schema)
+	if !ok {
+		return config, diags
+	}
+	if _, ok := schema.Blocks[listresourceattribute.ResourceFilterBlockName]; !ok {
+		return config, diags
+	}
+
+	schema.Blocks = maps.Clone(schema.Blocks)
+	delete(schema.Blocks, listresourceattribute.ResourceFilterBlockName)
+	typ := schema.Type().TerraformType(ctx)
+
+	switch {
+	case !config.Raw.IsKnown():
+		return tfsdk.Config{Raw: tftypes.NewValue(typ, tftypes.UnknownValue), Schema: schema}, diags
+	case config.Raw.IsNull():
+		return tfsdk.Config{Raw: tftypes.NewValue(typ, nil), Schema: schema}, diags
+	}
+
+	var values map[string]tftypes.Value
+	if err := config.Raw.As(&values); err != nil {
+		diags.AddError("Removing resource_filter from List configuration", err.Error())
+		return config, diags
+	}
+	delete(values, listresourceattribute.ResourceFilterBlockName)
+
+	return tfsdk.Config{Raw: tftypes.NewValue(typ, values), Schema: schema}, diags
+}
+
+// regionAttribute returns a getAttributeFunc which reads the specified Region as the value of the top-level "region" attribute.
+func regionAttribute(region string) getAttributeFunc {
+	return func(_ context.Context, _ path.Path, target any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if v, ok := target.(*types.String); ok {
+			*v = types.StringValue(region)
+		}
+
+		return diags
+	}
+}
+
+// listWithResourceFilter runs a wrapped List handler with the shared "resource_filter" block applied.
+// The block is removed from the configuration seen by the handler.
+// If Regions are filtered, the handler is run once for each Region.
+func listWithResourceFilter(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, newContext func(context.Context, getAttributeFunc) (context.Context, diag.Diagnostics), handler func(context.Context, list.ListRequest, *list.ListResultsStream)) {
+	stream.Results = tfiter.Null[list.ListResult]()
+
+	filter, diags := expandListResourceFilter(ctx, request.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	getAttributes := []getAttributeFunc{request.Config.GetAttribute}
+	if len(filter.Regions) > 0 {
+		var region types.String
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		if !region.IsNull() {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Attribute Combination", "region cannot be set together with resource_filter.regions")
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		getAttributes = getAttributes[:0]
+		for _, region := range filter.Regions {
+			getAttributes = append(getAttributes, regionAttribute(region))
+		}
+	}
+
+	config, d := removeListResourceFilter(ctx, request.Config)
+	diags.Append(d...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	request.Config = config
+
+	for _, getAttribute := range getAttributes {
+		regionCtx, d := newContext(ctx, getAttribute)
+		if len(d) > 0 {
+			stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(d))
+		}
+		if d.HasError() {
+			return
+		}
+
+		// Each Region's results are filtered separately.
+		regionFilter := *filter
+		regionCtx = listresource.NewFilterContext(regionCtx, &regionFilter)
+
+		regionStream := list.ListResultsStream{
+			Results: tfiter.Null[list.ListResult](),
+		}
+		handler(regionCtx, request, &regionStream)
+
+		stream.Results = tfiter.Concat(stream.Results, listresource.FilterResults(regionStream.Results, &regionFilter))
+	}
+}
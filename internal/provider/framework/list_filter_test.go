@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type listFilterTestRegionKey struct{}
+
+func TestListWithResourceFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrRegion: listresourceattribute.Region(),
+		},
+		Blocks: map[string]listschema.Block{
+			listresourceattribute.ResourceFilterBlockName: listresourceattribute.ResourceFilter(true, true),
+		},
+	}
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	filterType := typ.AttributeTypes[listresourceattribute.ResourceFilterBlockName].(tftypes.Object)
+	stringListType := tftypes.List{ElementType: tftypes.String}
+
+	config := func(region any, filter map[string]tftypes.Value) tfsdk.Config {
+		filterValue := tftypes.NewValue(filterType, nil)
+		if filter != nil {
+			values := map[string]tftypes.Value{
+				listresourceattribute.ResourceFilterAttrNamePrefix: tftypes.NewValue(tftypes.String, nil),
+				listresourceattribute.ResourceFilterAttrRegions:    tftypes.NewValue(stringListType, nil),
+				listresourceattribute.ResourceFilterAttrTagKeys:    tftypes.NewValue(stringListType, nil),
+				listresourceattribute.ResourceFilterAttrTags:       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}
+			for k, v := range filter {
+				values[k] = v
+			}
+			filterValue = tftypes.NewValue(filterType, values)
+		}
+
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+				names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+				listresourceattribute.ResourceFilterBlockName: filterValue,
+			}),
+			Schema: s,
+		}
+	}
+	stringList := func(v ...string) tftypes.Value {
+		values := make([]tftypes.Value, len(v))
+		for i, v := range v {
+			values[i] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(stringListType, values)
+	}
+
+	newContext := func(ctx context.Context, getAttribute getAttributeFunc) (context.Context, diag.Diagnostics) {
+		var region types.String
+		diags := getAttribute(ctx, path.Root(names.AttrRegion), &region)
+		if region.IsNull() {
+			region = types.StringValue("us-west-2")
+		}
+
+		return context.WithValue(ctx, listFilterTestRegionKey{}, region.ValueString()), diags
+	}
+	handler := func(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+		// List handlers read their configuration into models without the resource_filter block.
+		var query struct {
+			Region types.String `tfsdk:"region"`
+		}
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		region := ctx.Value(listFilterTestRegionKey{}).(string)
+		stream.Results = func(yield func(list.ListResult) bool) {
+			for _, name := range []string{"alpha", "beta"} {
+				if !yield(list.ListResult{DisplayName: region + "/" + name}) {
+					return
+				}
+			}
+		}
+	}
+
+	testCases := map[string]struct {
+		config        tfsdk.Config
+		expected      []string
+		expectedError bool
+	}{
+		"no filter": {
+			config:   config(nil, nil),
+			expected: []string{"us-west-2/alpha", "us-west-2/beta"},
+		},
+		"region": {
+			config:   config("us-east-1", nil),
+			expected: []string{"us-east-1/alpha", "us-east-1/beta"},
+		},
+		"name prefix": {
+			config: config(nil, map[string]tftypes.Value{
+				listresourceattribute.ResourceFilterAttrNamePrefix: tftypes.NewValue(tftypes.String, "us-west-2/b"),
+			}),
+			expected: []string{"us-west-2/beta"},
+		},
+		"regions": {
+			config: config(nil, map[string]tftypes.Value{
+				listresourceattribute.ResourceFilterAttrRegions: stringList("us-east-1", "eu-west-1"),
+			}),
+			expected: []string{"us-east-1/alpha", "us-east-1/beta", "eu-west-1/alpha", "eu-west-1/beta"},
+		},
+		"regions conflict with region": {
+			config: config("us-east-1", map[string]tftypes.Value{
+				listresourceattribute.ResourceFilterAttrRegions: stringList("us-east-1", "eu-west-1"),
+			}),
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var stream list.ListResultsStream
+			listWithResourceFilter(ctx, list.ListRequest{Config: testCase.config}, &stream, newContext, handler)
+
+			var got []string
+			var diags diag.Diagnostics
+			for result := range stream.Results {
+				diags.Append(result.Diagnostics...)
+				if !result.Diagnostics.HasError() {
+					got = append(got, result.DisplayName)
+				}
+			}
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Fatalf("error = %t, want %t: %v", got, want, diags)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterResults(t *testing.T) {
+	t.Parallel()
+
+	results := []list.ListResult{
+		{DisplayName: "tf-acc-test-1"},
+		{DisplayName: "other"},
+		{DisplayName: "tf-acc-test-2", Diagnostics: diag.Diagnostics{diag.NewWarningDiagnostic("warning", "")}},
+		{Diagnostics: diag.Diagnostics{diag.NewErrorDiagnostic("error", "")}},
+	}
+
+	var got []string
+	for result := range listresource.FilterResults(slices.Values(results), &listresource.Filter{NamePrefix: "tf-acc-test-"}) {
+		got = append(got, result.DisplayName)
+	}
+
+	if diff := cmp.Diff(got, []string{"tf-acc-test-1", "tf-acc-test-2", ""}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Filter is the shared filter applied to the results of every list resource.
+// A result is returned only if it matches every criterion that is set.
+type Filter struct {
+	// NamePrefix selects results whose display name starts with the prefix.
+	NamePrefix string
+	// Regions lists the Regions to query. Handled by the list resource wrapper.
+	Regions []string
+	// TagKeys selects results with all of the tag keys, whatever their values.
+	TagKeys []string
+	// Tags selects results with all of the tags.
+	Tags map[string]string
+}
+
+func (f *Filter) hasTags() bool {
+	return len(f.TagKeys) > 0 || len(f.Tags) > 0
+}
+
+func (f *Filter) matchName(name string) bool {
+	return strings.HasPrefix(name, f.NamePrefix)
+}
+
+func (f *Filter) matchTags(tags map[string]string) bool {
+	for _, k := range f.TagKeys {
+		if _, ok := tags[k]; !ok {
+			return false
+		}
+	}
+
+	for k, v := range f.Tags {
+		if tag, ok := tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+var filterKey = inttypes.NewContextKey[*Filter]()
+
+// NewFilterContext returns a new context with the specified filter stored.
+func NewFilterContext(ctx context.Context, filter *Filter) context.Context {
+	return filterKey.NewContext(ctx, filter)
+}
+
+// FilterFromContext extracts the filter from the context, if present.
+func FilterFromContext(ctx context.Context) (*Filter, bool) {
+	filter := filterKey.FromContext(ctx)
+	return filter, filter != nil
+}
+
+// excludedDiagnostic marks a result which does not match the filter's tags.
+// The mark travels with the result, so results are filtered correctly whatever order they are set and yielded in.
+// Marked results are dropped by FilterResults before reaching Terraform.
+type excludedDiagnostic struct{}
+
+var _ diag.Diagnostic = excludedDiagnostic{}
+
+func (excludedDiagnostic) Severity() diag.Severity {
+	return diag.SeverityWarning
+}
+
+func (excludedDiagnostic) Summary() string {
+	return "Result Excluded"
+}
+
+func (excludedDiagnostic) Detail() string {
+	return "The result does not match the resource_filter tags."
+}
+
+func (excludedDiagnostic) Equal(o diag.Diagnostic) bool {
+	_, ok := o.(excludedDiagnostic)
+	return ok
+}
+
+func isExcludedDiagnostic(d diag.Diagnostic) bool {
+	_, ok := d.(excludedDiagnostic)
+	return ok
+}
+
+func withoutExcludedDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	return tfslices.Filter(diags, func(d diag.Diagnostic) bool {
+		return !isExcludedDiagnostic(d)
+	})
+}
+
+// FilterResults drops the results which do not match the filter.
+// Results with errors are always returned.
+func FilterResults(results iter.Seq[list.ListResult], filter *Filter) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		for result := range results {
+			excluded := slices.ContainsFunc(result.Diagnostics, isExcludedDiagnostic)
+
+			if !result.Diagnostics.HasError() && (excluded || !filter.matchName(result.DisplayName)) {
+				continue
+			}
+
+			if excluded {
+				result.Diagnostics = withoutExcludedDiagnostics(result.Diagnostics)
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// excludeFilteredResult marks the result if its tags do not match the filter in context.
+// Tags are read from the service API if the List handler didn't set them.
+func excludeFilteredResult(ctx context.Context, h interceptors.HTags, c *conns.AWSClient, includeResource bool, result *list.ListResult, getIdentifier func() string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// List handlers may reuse a result, so clear any mark left from a previous one.
+	result.Diagnostics = withoutExcludedDiagnostics(result.Diagnostics)
+
+	filter, ok := FilterFromContext(ctx)
+	if !ok || !filter.hasTags() {
+		return diags
+	}
+
+	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	if !ok {
+		return diags
+	}
+
+	if tagsInContext.TagsOut.IsNone() {
+		if identifier := getIdentifier(); identifier != "" {
+			if err := h.ListTags(ctx, sp, c, identifier); err != nil {
+				diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+
+				return diags
+			}
+		}
+	}
+
+	tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).Map()
+
+	// The tags interceptor resets tags in context when the resource is included.
+	if !includeResource {
+		tagsInContext.TagsOut = nil
+	}
+
+	if !filter.matchTags(tags) {
+		result.Diagnostics.Append(excludedDiagnostic{})
+	}
+
+	return diags
+}
+
+type filterInterceptor struct {
+	interceptors.HTags
+}
+
+// FilterInterceptor excludes results which do not match the tags of the filter in context.
+// It must be appended after TagsInterceptor so that it runs first on After.
+func FilterInterceptor(tags unique.Handle[inttypes.ServicePackageResourceTags]) filterInterceptor {
+	return filterInterceptor{
+		HTags: interceptors.HTags(tags),
+	}
+}
+
+func (r filterInterceptor) Read(ctx context.Context, params InterceptorParams) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch params.When {
+	case After:
+		diags.Append(excludeFilteredResult(ctx, r.HTags, params.C, params.IncludeResource, params.Result, func() string {
+			return r.GetIdentifierFramework(ctx, params.Result.Resource)
+		})...)
+	}
+
+	return diags
+}
+
+type filterInterceptorSDK struct {
+	interceptors.HTags
+}
+
+// FilterInterceptorSDK excludes results which do not match the tags of the filter in context.
+// It must be appended after TagsInterceptorSDK so that it runs first on After.
+func FilterInterceptorSDK(tags unique.Handle[inttypes.ServicePackageResourceTags]) filterInterceptorSDK {
+	return filterInterceptorSDK{
+		HTags: interceptors.HTags(tags),
+	}
+}
+
+func (r filterInterceptorSDK) Read(ctx context.Context, params InterceptorParamsSDK) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch params.When {
+	case After:
+		diags.Append(excludeFilteredResult(ctx, r.HTags, params.C, params.IncludeResource, params.Result, func() string {
+			return r.GetIdentifierSDKv2(ctx, params.ResourceData)
+		})...)
+	}
+
+	return diags
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func TestFilterResultsExcluded(t *testing.T) {
+	t.Parallel()
+
+	filter := &Filter{Tags: map[string]string{"Name": "test"}}
+	// Results are yielded in a different order from that in which they were set.
+	results := []list.ListResult{
+		{DisplayName: "not-set"},
+		{DisplayName: "mismatch-with-warning", Diagnostics: diag.Diagnostics{excludedDiagnostic{}, diag.NewWarningDiagnostic("warning", "")}},
+		{DisplayName: "mismatch-with-error", Diagnostics: diag.Diagnostics{excludedDiagnostic{}, diag.NewErrorDiagnostic("error", "")}},
+		{DisplayName: "mismatch", Diagnostics: diag.Diagnostics{excludedDiagnostic{}}},
+		{DisplayName: "match"},
+	}
+
+	var got []string
+	for result := range FilterResults(slices.Values(results), filter) {
+		if slices.ContainsFunc(result.Diagnostics, isExcludedDiagnostic) {
+			t.Errorf("result (%s) returned with exclusion mark", result.DisplayName)
+		}
+		got = append(got, result.DisplayName)
+	}
+
+	if diff := cmp.Diff(got, []string{"not-set", "mismatch-with-error", "match"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
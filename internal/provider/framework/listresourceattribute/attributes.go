@@ -7,12 +7,58 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	ResourceFilterBlockName      = "resource_filter"
+	ResourceFilterAttrNamePrefix = names.AttrNamePrefix
+	ResourceFilterAttrRegions    = "regions"
+	ResourceFilterAttrTagKeys    = "tag_keys"
+	ResourceFilterAttrTags       = names.AttrTags
+)
+
 var Region = sync.OnceValue(func() schema.StringAttribute {
 	return schema.StringAttribute{
 		Optional:    true,
 		Description: names.ListResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+// ResourceFilter returns the shared "resource_filter" block.
+// Tag arguments are included only for taggable resources and `regions` only for resources with Region override enabled.
+func ResourceFilter(tags, regions bool) schema.SingleNestedBlock {
+	attributes := map[string]schema.Attribute{
+		ResourceFilterAttrNamePrefix: schema.StringAttribute{
+			Optional:    true,
+			Description: "Return only resources whose display name starts with this prefix.",
+		},
+	}
+
+	if tags {
+		attributes[ResourceFilterAttrTagKeys] = schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Return only resources with all of these tag keys, whatever their values.",
+		}
+		attributes[ResourceFilterAttrTags] = schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Return only resources with all of these tags.",
+		}
+	}
+
+	if regions {
+		attributes[ResourceFilterAttrRegions] = schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Regions to query for resources of this type. Conflicts with `region`.",
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes:  attributes,
+		Description: "Filters the resources returned. A resource is returned only if it matches every argument that is set.",
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"iter"
 	"log"
+	"maps"
 	"reflect"
 	"slices"
 	"sync"
@@ -153,6 +154,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"retry_budget": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "The maximum total time spent waiting between retries of AWS API requests, across all services, before requests fail without further retries. Valid time units are ns, us (or µs), ms, s, h, or m.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -204,11 +210,18 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys by resource type, tag key capitalization, and allowed tag values. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_document": schema.StringAttribute{
+				Optional: true,
+				Description: `The path to, or JSON content of, an organizational tag policy document. ` +
+					`When set, tag policy compliance is enforced against this document instead of the effective tag policy retrieved from AWS. ` +
+					`Has no effect unless tag_policy_compliance is enabled. ` +
+					`Can also be configured with the ` + tftags.TagPolicyDocumentEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -315,6 +328,29 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"circuit_breaker": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration for failing fast on AWS API requests to a service whose recent requests have mostly failed with retryable errors.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_threshold": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The fraction of requests to a service failing with retryable errors above which the circuit breaker opens. Defaults to `0.5`.",
+						},
+						"minimum_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The minimum number of requests to a service within a window before the circuit breaker can open. Defaults to `20`.",
+						},
+						"window": schema.StringAttribute{
+							CustomType:  timetypes.GoDurationType{},
+							Optional:    true,
+							Description: "The period over which requests are counted and for which an open circuit breaker fails requests fast. Defaults to `1m`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -329,6 +365,15 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scope": tagsScopeBlock("Configuration block with resource tags to default across a subset of resources.", map[string]schema.Attribute{
+							"tags": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Resource tags to default across resources within the scope.",
+							},
+						}),
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -352,8 +397,75 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scope": tagsScopeBlock("Configuration block with settings to ignore resource tags across a subset of resources.", map[string]schema.Attribute{
+							"key_prefixes": schema.SetAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Resource tag key prefixes to ignore across resources within the scope.",
+							},
+							"keys": schema.SetAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Resource tag keys to ignore across resources within the scope.",
+							},
+						}),
+					},
 				},
 			},
+			"service_limits": schema.ListNestedBlock{
+				Description: "Client-side request limits for individual AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an API request to the service is attempted. Overrides the provider-level `max_retries`.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum rate at which API requests, including retries, are sent to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// tagsScopeBlock returns the schema for a repeatable block which limits tagging settings to a subset of resources.
+func tagsScopeBlock(description string, attributes map[string]schema.Attribute) schema.ListNestedBlock {
+	a := map[string]schema.Attribute{
+		"exclude_resource_types": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Resource type names, e.g. `aws_s3_bucket`, excluded from the scope.",
+		},
+		"exclude_services": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Service package names, e.g. `ec2`, excluded from the scope.",
+		},
+		"resource_types": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Resource type names, e.g. `aws_s3_bucket`, included in the scope.",
+		},
+		"services": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Service package names, e.g. `ec2`, included in the scope.",
+		},
+	}
+	maps.Copy(a, attributes)
+
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: a,
 		},
 	}
 }
@@ -423,7 +535,18 @@ func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNLikeFunction,
+		tffunction.NewARNLikeFilterFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewECRImageURIBuildFunction,
+		tffunction.NewECRImageURIParseFunction,
+		tffunction.NewIAMPolicyEvaluateFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewScheduleNextRunsFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
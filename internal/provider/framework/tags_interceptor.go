@@ -6,7 +6,6 @@ package framework
 import (
 	"context"
 	"fmt"
-	"slices"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -263,7 +262,8 @@ func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttype
 	}
 }
 
-// resourceValidateRequiredTags validates that required tags are present for a given resource type.
+// resourceValidateRequiredTags validates that required tags are present for a given resource type
+// and that tag keys and values comply with the tag policy.
 func resourceValidateRequiredTags() resourceModifyPlanInterceptor {
 	return &resourceValidateRequiredTagsInterceptor{}
 }
@@ -282,8 +282,7 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	if _, ok := policy.RequiredTags[typeName]; !ok && len(policy.TagRules) == 0 {
 		return
 	}
 
@@ -315,21 +314,13 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
-		}
-
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
-
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		for _, v := range policy.Violations(typeName, allPlanTags) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), v.Summary, v.Detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), v.Summary, v.Detail)
+			}
 		}
 	}
 }
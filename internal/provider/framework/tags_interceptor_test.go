@@ -44,6 +44,12 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 				"bar": nil,
 			},
 		},
+		TagRules: map[string]tftags.TagPolicyRule{
+			"costcenter": {
+				Key:           "CostCenter",
+				AllowedValues: []string{"100"},
+			},
+		},
 	}
 }
 
@@ -117,6 +123,17 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	}
 	rawValRequired := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsRequired)
 
+	// Noncompliant tag key and value
+	attrsNoncompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"foo":        tftypes.NewValue(tftypes.String, nil),
+			"bar":        tftypes.NewValue(tftypes.String, nil),
+			"costcenter": tftypes.NewValue(tftypes.String, "200"),
+		}),
+	}
+	rawValNoncompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsNoncompliant)
+
 	// Unknown tag values
 	attrsUnknown := map[string]tftypes.Value{
 		"name": tftypes.NewValue(tftypes.String, "test"),
@@ -225,6 +242,45 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 				when: Before,
 			},
 		},
+		{
+			name: "create, noncompliant tags",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockRequiredTagsClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tag Keys",
+					"An organizational tag policy requires different capitalization of the following tag keys for aws_test: [costcenter (expected CostCenter)]",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tag Values",
+					`An organizational tag policy does not allow the following tag values for aws_test: [costcenter="200" (allowed: 100)]`,
+				),
+			},
+		},
 		{
 			name: "create, unknown tag values",
 			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
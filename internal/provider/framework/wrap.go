@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
-	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/importer"
@@ -868,6 +867,7 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 		v.SetIdentitySpec(spec.Identity)
 	}
 
+	var isTagFilterEnabled bool
 	if v, ok := inner.(framework.Lister[listresource.InterceptorParams]); ok {
 		if isRegionOverrideEnabled {
 			v.AppendResultInterceptor(listresource.SetRegionInterceptor())
@@ -880,9 +880,13 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 
 		if !tfunique.IsHandleNil(spec.Tags) {
 			v.AppendResultInterceptor(listresource.TagsInterceptor(spec.Tags))
+			v.AppendResultInterceptor(listresource.FilterInterceptor(spec.Tags))
+			isTagFilterEnabled = true
 		}
 	}
 
+	interceptors = append(interceptors, listResourceInjectResourceFilterBlock(isTagFilterEnabled, isRegionOverrideEnabled))
+
 	return &wrappedListResourceFramework{
 		inner:              inner,
 		servicePackageName: servicePackageName,
@@ -940,17 +944,11 @@ func (w *wrappedListResourceFramework) Configure(ctx context.Context, request re
 }
 
 func (w *wrappedListResourceFramework) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
-	stream.Results = tfiter.Null[list.ListResult]()
-
-	ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
-	if len(diags) > 0 {
-		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
-	}
-	if diags.HasError() {
-		return
+	newContext := func(ctx context.Context, getAttribute getAttributeFunc) (context.Context, diag.Diagnostics) {
+		return w.context(ctx, getAttribute, w.meta)
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	listWithResourceFilter(ctx, request, stream, newContext, interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta))
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
@@ -983,7 +981,12 @@ var _ inttypes.ListResourceForSDK = &wrappedListResourceSDK{}
 func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, servicePackageName string) inttypes.ListResourceForSDK {
 	var interceptors interceptorInvocations
 
+	var isRegionOverrideEnabled bool
 	if v := spec.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
+
+	if isRegionOverrideEnabled {
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
@@ -998,12 +1001,17 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 		v.SetIdentitySpec(spec.Identity)
 	}
 
+	var isTagFilterEnabled bool
 	if v, ok := inner.(framework.Lister[listresource.InterceptorParamsSDK]); ok {
 		if !tfunique.IsHandleNil(spec.Tags) {
 			v.AppendResultInterceptor(listresource.TagsInterceptorSDK(spec.Tags))
+			v.AppendResultInterceptor(listresource.FilterInterceptorSDK(spec.Tags))
+			isTagFilterEnabled = true
 		}
 	}
 
+	interceptors = append(interceptors, listResourceInjectResourceFilterBlock(isTagFilterEnabled, isRegionOverrideEnabled))
+
 	return &wrappedListResourceSDK{
 		inner:              inner,
 		servicePackageName: servicePackageName,
@@ -1067,17 +1075,11 @@ func (w *wrappedListResourceSDK) Configure(ctx context.Context, request resource
 }
 
 func (w *wrappedListResourceSDK) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
-	stream.Results = tfiter.Null[list.ListResult]()
-
-	ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
-	if len(diags) > 0 {
-		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
-	}
-	if diags.HasError() {
-		return
+	newContext := func(ctx context.Context, getAttribute getAttributeFunc) (context.Context, diag.Diagnostics) {
+		return w.context(ctx, getAttribute, w.meta)
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	listWithResourceFilter(ctx, request, stream, newContext, interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta))
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
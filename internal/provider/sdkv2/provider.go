@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -76,6 +77,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"circuit_breaker":               circuitBreakerSchema(),
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -97,6 +99,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tags to default across all resources. " +
 									"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 							},
+							"scope": tagsScopeSchema("Configuration block with resource tags to default across a subset of resources.", map[string]*schema.Schema{
+								"tags": {
+									Type:        schema.TypeMap,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Resource tags to default across resources within the scope.",
+								},
+							}),
 						},
 					},
 				},
@@ -152,6 +162,20 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"scope": tagsScopeSchema("Configuration block with settings to ignore resource tags across a subset of resources.", map[string]*schema.Schema{
+								"keys": {
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Resource tag keys to ignore across resources within the scope.",
+								},
+								"key_prefixes": {
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Resource tag key prefixes to ignore across resources within the scope.",
+								},
+							}),
 						},
 					},
 				},
@@ -186,6 +210,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"retry_budget": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "The maximum total time spent waiting between retries of AWS API requests, " +
+						"across all services, before requests fail without further retries. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: verify.ValidDuration,
+				},
 				"retry_mode": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -213,6 +244,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_limits": serviceLimitsSchema(),
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -260,11 +292,19 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys by resource type, tag key capitalization, and allowed tag values. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_document": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The path to, or JSON content of, an organizational tag policy document. ` +
+						`When set, tag policy compliance is enforced against this document instead of the effective tag policy retrieved from AWS. ` +
+						`Has no effect unless tag_policy_compliance is enabled. ` +
+						`Can also be configured with the ` + tftags.TagPolicyDocumentEnvVar + ` environment variable.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -396,6 +436,25 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.RetryMode = mode
 	}
 
+	if v, ok := d.Get("retry_budget").(string); ok && v != "" {
+		budget, _ := time.ParseDuration(v)
+		config.RetryBudget = budget
+	}
+
+	if v, ok := d.GetOk("circuit_breaker"); ok && len(v.([]any)) > 0 {
+		tfMap, _ := v.([]any)[0].(map[string]any)
+		config.CircuitBreakerConfig = expandCircuitBreaker(tfMap)
+	}
+
+	if v, ok := d.GetOk("service_limits"); ok && len(v.([]any)) > 0 {
+		limits, dx := expandServiceLimits(cty.GetAttrPath("service_limits"), v.([]any))
+		diags = append(diags, dx...)
+		if dx.HasError() {
+			return nil, diags
+		}
+		config.ServiceLimits = limits
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		endpoint := conns.NormalizeS3USEast1RegionalEndpoint(v)
 		if endpoint == "legacy" {
@@ -502,6 +561,14 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	if dg.HasError() {
 		return nil, diags
 	}
+	if tagCfg != nil {
+		doc, dg := expandTagPolicyDocument(cty.GetAttrPath("tag_policy_document"), d.Get("tag_policy_document").(string))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		tagCfg.Document = doc
+	}
 	config.TagPolicyConfig = tagCfg
 
 	if v, ok := d.GetOk("max_retries"); ok {
@@ -1049,6 +1116,109 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+// circuitBreakerSchema returns the schema for the block which configures per-service circuit breakers.
+func circuitBreakerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Configuration for failing fast on AWS API requests to a service " +
+			"whose recent requests have mostly failed with retryable errors.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"error_threshold": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The fraction of requests to a service failing with retryable errors above which the circuit breaker opens. Defaults to `0.5`.",
+					ValidateFunc: validation.FloatBetween(0, 1),
+				},
+				"minimum_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The minimum number of requests to a service within a window before the circuit breaker can open. Defaults to `20`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"window": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The period over which requests are counted and for which an open circuit breaker fails requests fast. Defaults to `1m`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: verify.ValidDuration,
+				},
+			},
+		},
+	}
+}
+
+// serviceLimitsSchema returns the schema for a repeatable block which configures client-side request limits for an AWS service.
+func serviceLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Client-side request limits for individual AWS services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times an API request to the service is attempted. Overrides the provider-level `max_retries`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The maximum rate at which API requests, including retries, are sent to the service.",
+					ValidateFunc: verify.FloatGreaterThan(0),
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, using the same names as the `endpoints` block.",
+				},
+			},
+		},
+	}
+}
+
+// tagsScopeSchema returns the schema for a repeatable block which limits tagging settings to a subset of resources.
+func tagsScopeSchema(description string, attributes map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"exclude_resource_types": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Resource type names, e.g. `aws_s3_bucket`, excluded from the scope.",
+		},
+		"exclude_services": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Service package names, e.g. `ec2`, excluded from the scope.",
+		},
+		"resource_types": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Resource type names, e.g. `aws_s3_bucket`, included in the scope.",
+		},
+		"services": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Service package names, e.g. `ec2`, included in the scope.",
+		},
+	}
+	maps.Copy(s, attributes)
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -1158,6 +1328,34 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func expandCircuitBreaker(tfMap map[string]any) *conns.CircuitBreakerConfig {
+	// Set defaults here, not in schema (muxing with v6 provider).
+	config := conns.CircuitBreakerConfig{
+		ErrorThreshold:  0.5,
+		MinimumRequests: 20,
+		Window:          1 * time.Minute,
+	}
+
+	if tfMap == nil {
+		return &config
+	}
+
+	if v, ok := tfMap["error_threshold"].(float64); ok && v != 0 {
+		config.ErrorThreshold = v
+	}
+
+	if v, ok := tfMap["minimum_requests"].(int); ok && v != 0 {
+		config.MinimumRequests = v
+	}
+
+	if v, ok := tfMap["window"].(string); ok && v != "" {
+		window, _ := time.ParseDuration(v)
+		config.Window = window
+	}
+
+	return &config
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1171,12 +1369,35 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var scopedTags []tftags.ScopedDefaultConfig
+	if v, ok := tfMap["scope"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			scoped := tftags.ScopedDefaultConfig{
+				Scope: expandTagsScope(tfMap),
+			}
+			if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+				scoped.Tags = tftags.New(ctx, v)
+			}
+			scopedTags = append(scopedTags, scoped)
 		}
 	}
 
+	if len(tags) > 0 || len(scopedTags) > 0 {
+		defaultConfig := &tftags.DefaultConfig{
+			ScopedTags: scopedTags,
+		}
+		if len(tags) > 0 {
+			defaultConfig.Tags = tftags.New(ctx, tags)
+		}
+
+		return defaultConfig
+	}
+
 	return nil
 }
 
@@ -1208,16 +1429,41 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		}
 	}
 
+	var scoped []tftags.ScopedIgnoreConfig
+	if tfMap != nil {
+		if v, ok := tfMap["scope"].([]any); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				apiObject := tftags.ScopedIgnoreConfig{
+					Scope: expandTagsScope(tfMap),
+				}
+				if v, ok := tfMap["keys"].(*schema.Set); ok && v.Len() > 0 {
+					apiObject.Keys = tftags.New(ctx, v.List())
+				}
+				if v, ok := tfMap["key_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+					apiObject.KeyPrefixes = tftags.New(ctx, v.List())
+				}
+				scoped = append(scoped, apiObject)
+			}
+		}
+	}
+
 	// To preseve behavior prior to supporting environment variables:
 	//
 	// - Return nil when no keys or prefixes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(scoped) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		Scoped: scoped,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -1228,6 +1474,73 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandServiceLimits(path cty.Path, tfList []any) (map[string]conns.ServiceLimitsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limits := make(map[string]conns.ServiceLimitsConfig)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName := service
+		if !slices.Contains(names.ProviderPackages(), service) {
+			v, err := names.ProviderPackageForAlias(service)
+			if err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath.GetAttr("service"),
+					"Invalid Service",
+					fmt.Sprintf("%q is not a supported service.", service),
+				))
+				continue
+			}
+			servicePackageName = v
+		}
+
+		if _, ok := limits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Duplicate Service",
+				fmt.Sprintf("Limits for service %q are configured more than once.", service),
+			))
+			continue
+		}
+
+		var config conns.ServiceLimitsConfig
+		if v, ok := tfMap["max_retries"].(int); ok && v != 0 {
+			config.MaxRetries = v
+		}
+		if v, ok := tfMap["requests_per_second"].(float64); ok && v != 0 {
+			config.RequestsPerSecond = v
+		}
+		limits[servicePackageName] = config
+	}
+
+	return limits, diags
+}
+
+func expandTagsScope(tfMap map[string]any) tftags.ResourceScope {
+	var scope tftags.ResourceScope
+
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ExcludeServicePackages = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ServicePackages = flex.ExpandStringValueSet(v)
+	}
+
+	return scope
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -1240,6 +1553,27 @@ func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyCon
 	return nil, nil
 }
 
+// expandTagPolicyDocument returns the JSON content of the configured tag policy document.
+// The value may be either inline JSON or the path to a file containing the document.
+func expandTagPolicyDocument(path cty.Path, v string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v == "" {
+		v = os.Getenv(tftags.TagPolicyDocumentEnvVar)
+	}
+
+	if v == "" || strings.HasPrefix(strings.TrimSpace(v), "{") {
+		return v, diags
+	}
+
+	b, err := tfio.ReadFileContents(v)
+	if err != nil {
+		return "", append(diags, errs.NewAttributeErrorDiagnostic(path, "Reading Tag Policy Document", err.Error()))
+	}
+
+	return string(b), diags
+}
+
 func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
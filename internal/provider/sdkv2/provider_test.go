@@ -4,7 +4,9 @@
 package sdkv2
 
 import (
+	"maps"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -425,6 +428,204 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandTagsScope(t *testing.T) { //nolint:paralleltest
+	ctx := t.Context()
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	scope := map[string]any{
+		"exclude_resource_types": schema.NewSet(schema.HashString, []any{"aws_s3_object"}),
+		"exclude_services":       schema.NewSet(schema.HashString, []any{}),
+		"resource_types":         schema.NewSet(schema.HashString, []any{"aws_ebs_volume"}),
+		"services":               schema.NewSet(schema.HashString, []any{"s3"}),
+	}
+	expectedScope := tftags.ResourceScope{
+		ExcludeResourceTypes: []string{"aws_s3_object"},
+		ResourceTypes:        []string{"aws_ebs_volume"},
+		ServicePackages:      []string{"s3"},
+	}
+
+	defaultScope := maps.Clone(scope)
+	defaultScope["tags"] = map[string]any{
+		"Backup": "daily",
+	}
+	defaultConfig := expandDefaultTags(ctx, map[string]any{
+		"scope": []any{defaultScope},
+	})
+	expectedDefaultConfig := &tftags.DefaultConfig{
+		ScopedTags: []tftags.ScopedDefaultConfig{
+			{
+				Scope: expectedScope,
+				Tags: tftags.New(ctx, map[string]string{
+					"Backup": "daily",
+				}),
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedDefaultConfig, defaultConfig); diff != "" {
+		t.Errorf("Unexpected default_tags diff: %s", diff)
+	}
+
+	ignoreScope := maps.Clone(scope)
+	ignoreScope["keys"] = schema.NewSet(schema.HashString, []any{})
+	ignoreScope["key_prefixes"] = schema.NewSet(schema.HashString, []any{"kubernetes.io/"})
+	ignoreConfig := expandIgnoreTags(ctx, map[string]any{
+		"keys":         schema.NewSet(schema.HashString, []any{"example"}),
+		"key_prefixes": schema.NewSet(schema.HashString, []any{}),
+		"scope":        []any{ignoreScope},
+	})
+	expectedIgnoreConfig := &tftags.IgnoreConfig{
+		Keys: tftags.New(ctx, []any{"example"}),
+		Scoped: []tftags.ScopedIgnoreConfig{
+			{
+				Scope:       expectedScope,
+				KeyPrefixes: tftags.New(ctx, []any{"kubernetes.io/"}),
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedIgnoreConfig, ignoreConfig); diff != "" {
+		t.Errorf("Unexpected ignore_tags diff: %s", diff)
+	}
+}
+
+func TestExpandTagPolicyDocument(t *testing.T) { //nolint:paralleltest
+	const document = `{"tags": {"owner": {"report_required_tag_for": ["logs:log-group"]}}}`
+
+	filename := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(filename, []byte(document), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		value       string
+		envvars     map[string]string
+		expected    string
+		expectDiags bool
+	}{
+		"unset": {},
+		"inline": {
+			value:    document,
+			expected: document,
+		},
+		"file": {
+			value:    filename,
+			expected: document,
+		},
+		"missing file": {
+			value:       filepath.Join(t.TempDir(), "missing.json"),
+			expectDiags: true,
+		},
+		"envvar": {
+			envvars: map[string]string{
+				tftags.TagPolicyDocumentEnvVar: filename,
+			},
+			expected: document,
+		},
+		"envvar and config": {
+			value: `{}`,
+			envvars: map[string]string{
+				tftags.TagPolicyDocumentEnvVar: filename,
+			},
+			expected: `{}`,
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			result, diags := expandTagPolicyDocument(cty.GetAttrPath("tag_policy_document"), testcase.value)
+
+			if got, want := diags.HasError(), testcase.expectDiags; got != want {
+				t.Fatalf("expected errors %t, got %t: %v", want, got, diags)
+			}
+
+			if result != testcase.expected {
+				t.Errorf("expected %q, got %q", testcase.expected, result)
+			}
+		})
+	}
+}
+
+func TestExpandServiceLimits(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList      []any
+		expected    map[string]conns.ServiceLimitsConfig
+		expectDiags bool
+	}{
+		"service package names and aliases": {
+			tfList: []any{
+				map[string]any{
+					"service":             "route53",
+					"max_retries":         0,
+					"requests_per_second": 5.0,
+				},
+				map[string]any{
+					"service":             "cloudwatchlogs",
+					"max_retries":         10,
+					"requests_per_second": 0.0,
+				},
+			},
+			expected: map[string]conns.ServiceLimitsConfig{
+				names.Route53: {RequestsPerSecond: 5},
+				names.Logs:    {MaxRetries: 10},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"service":             "notaservice",
+					"max_retries":         10,
+					"requests_per_second": 0.0,
+				},
+			},
+			expected:    map[string]conns.ServiceLimitsConfig{},
+			expectDiags: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"service":             "logs",
+					"max_retries":         10,
+					"requests_per_second": 0.0,
+				},
+				map[string]any{
+					"service":             "cloudwatchlogs",
+					"max_retries":         5,
+					"requests_per_second": 0.0,
+				},
+			},
+			expected: map[string]conns.ServiceLimitsConfig{
+				names.Logs: {MaxRetries: 10},
+			},
+			expectDiags: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, diags := expandServiceLimits(cty.GetAttrPath("service_limits"), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectDiags; got != want {
+				t.Fatalf("expected errors %t, got %t: %v", want, got, diags)
+			}
+
+			if diff := cmp.Diff(testcase.expected, result); diff != "" {
+				t.Errorf("unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
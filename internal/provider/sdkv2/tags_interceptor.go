@@ -5,8 +5,8 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"slices"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -313,8 +313,7 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		if _, ok := policy.RequiredTags[typeName]; !ok && len(policy.TagRules) == 0 {
 			return nil
 		}
 
@@ -335,27 +334,24 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
-				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				var errs []error
+				for _, v := range policy.Violations(typeName, allTags) {
+					// CustomizeDiff does not support diagnostics (only an error return)
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "Tag Policy Validation", map[string]any{
+							"summary": v.Summary,
+							"detail":  v.Detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", v.Summary, v.Detail))
+					}
 				}
+
+				return errors.Join(errs...)
 			}
 		}
 
@@ -43,6 +43,10 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 )
 
 // @SDKResource("aws_autoscaling_group", name="Group")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/autoscaling/types;awstypes;awstypes.AutoScalingGroup")
+// @Testing(preIdentityVersion="v6.33.0")
+// @Testing(idAttrDuplicates="name")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -50,10 +54,6 @@ func resourceGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceGroupUpdate,
 		DeleteWithoutTimeout: resourceGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -1325,8 +1325,8 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.AutoScalingClient(ctx)
 
 	g, err := findGroupByName(ctx, conn, d.Id())
 
@@ -1340,82 +1340,8 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, g.AutoScalingGroupARN)
-	d.Set(names.AttrAvailabilityZones, g.AvailabilityZones)
-	d.Set("availability_zone_distribution", []any{flattenAvailabilityZoneDistribution(g.AvailabilityZoneDistribution)})
-	d.Set("capacity_rebalance", g.CapacityRebalance)
-	d.Set("capacity_reservation_specification", []any{flattenCapacityReservationSpecification(g.CapacityReservationSpecification)})
-	d.Set("context", g.Context)
-	d.Set("default_cooldown", g.DefaultCooldown)
-	d.Set("default_instance_warmup", g.DefaultInstanceWarmup)
-	d.Set("desired_capacity", g.DesiredCapacity)
-	d.Set("desired_capacity_type", g.DesiredCapacityType)
-	if len(g.EnabledMetrics) > 0 {
-		d.Set("enabled_metrics", flattenEnabledMetrics(g.EnabledMetrics))
-		d.Set("metrics_granularity", g.EnabledMetrics[0].Granularity)
-	} else {
-		d.Set("enabled_metrics", nil)
-		d.Set("metrics_granularity", defaultEnabledMetricsGranularity)
-	}
-	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
-	d.Set("health_check_type", g.HealthCheckType)
-	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
-	}
-	d.Set("launch_configuration", g.LaunchConfigurationName)
-	if g.LaunchTemplate != nil {
-		if err := d.Set(names.AttrLaunchTemplate, []any{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting launch_template: %s", err)
-		}
-	} else {
-		d.Set(names.AttrLaunchTemplate, nil)
-	}
-	d.Set("load_balancers", g.LoadBalancerNames)
-	d.Set("max_instance_lifetime", g.MaxInstanceLifetime)
-	d.Set("max_size", g.MaxSize)
-	d.Set("min_size", g.MinSize)
-	if g.MixedInstancesPolicy != nil {
-		if err := d.Set("mixed_instances_policy", []any{flattenMixedInstancesPolicy(g.MixedInstancesPolicy)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting mixed_instances_policy: %s", err)
-		}
-	} else {
-		d.Set("mixed_instances_policy", nil)
-	}
-	d.Set(names.AttrName, g.AutoScalingGroupName)
-	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(g.AutoScalingGroupName)))
-	d.Set("placement_group", g.PlacementGroup)
-	d.Set("predicted_capacity", g.PredictedCapacity)
-	d.Set("protect_from_scale_in", g.NewInstancesProtectedFromScaleIn)
-	d.Set("service_linked_role_arn", g.ServiceLinkedRoleARN)
-	d.Set("suspended_processes", flattenSuspendedProcesses(g.SuspendedProcesses))
-	if err := d.Set("traffic_source", flattenTrafficSourceIdentifiers(g.TrafficSources)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting traffic_source: %s", err)
-	}
-	d.Set("target_group_arns", g.TargetGroupARNs)
-	// If no termination polices are explicitly configured and the upstream state
-	// is only using the "Default" policy, clear the state to make it consistent
-	// with the default AWS Create API behavior.
-	if _, ok := d.GetOk("termination_policies"); !ok && len(g.TerminationPolicies) == 1 && g.TerminationPolicies[0] == defaultTerminationPolicy {
-		d.Set("termination_policies", nil)
-	} else {
-		d.Set("termination_policies", g.TerminationPolicies)
-	}
-	if len(aws.ToString(g.VPCZoneIdentifier)) > 0 {
-		d.Set("vpc_zone_identifier", strings.Split(aws.ToString(g.VPCZoneIdentifier), ","))
-	} else {
-		d.Set("vpc_zone_identifier", nil)
-	}
-	if g.WarmPoolConfiguration != nil {
-		if err := d.Set("warm_pool", []any{flattenWarmPoolConfiguration(g.WarmPoolConfiguration)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting warm_pool: %s", err)
-		}
-	} else {
-		d.Set("warm_pool", nil)
-	}
-	d.Set("warm_pool_size", g.WarmPoolSize)
-
-	if err := d.Set("tag", listOfMap(keyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting tag: %s", err)
+	if err := resourceGroupFlatten(ctx, c, g, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
 	}
 
 	return diags
@@ -2110,6 +2036,88 @@ func findGroup(ctx context.Context, conn *autoscaling.Client, input *autoscaling
 	return tfresource.AssertSingleValueResult(output)
 }
 
+func resourceGroupFlatten(ctx context.Context, client *conns.AWSClient, g *awstypes.AutoScalingGroup, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, g.AutoScalingGroupARN)
+	d.Set(names.AttrAvailabilityZones, g.AvailabilityZones)
+	d.Set("availability_zone_distribution", []any{flattenAvailabilityZoneDistribution(g.AvailabilityZoneDistribution)})
+	d.Set("capacity_rebalance", g.CapacityRebalance)
+	d.Set("capacity_reservation_specification", []any{flattenCapacityReservationSpecification(g.CapacityReservationSpecification)})
+	d.Set("context", g.Context)
+	d.Set("default_cooldown", g.DefaultCooldown)
+	d.Set("default_instance_warmup", g.DefaultInstanceWarmup)
+	d.Set("desired_capacity", g.DesiredCapacity)
+	d.Set("desired_capacity_type", g.DesiredCapacityType)
+	if len(g.EnabledMetrics) > 0 {
+		d.Set("enabled_metrics", flattenEnabledMetrics(g.EnabledMetrics))
+		d.Set("metrics_granularity", g.EnabledMetrics[0].Granularity)
+	} else {
+		d.Set("enabled_metrics", nil)
+		d.Set("metrics_granularity", defaultEnabledMetricsGranularity)
+	}
+	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
+	d.Set("health_check_type", g.HealthCheckType)
+	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
+		return fmt.Errorf("setting instance_maintenance_policy: %w", err)
+	}
+	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if g.LaunchTemplate != nil {
+		if err := d.Set(names.AttrLaunchTemplate, []any{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
+			return fmt.Errorf("setting launch_template: %w", err)
+		}
+	} else {
+		d.Set(names.AttrLaunchTemplate, nil)
+	}
+	d.Set("load_balancers", g.LoadBalancerNames)
+	d.Set("max_instance_lifetime", g.MaxInstanceLifetime)
+	d.Set("max_size", g.MaxSize)
+	d.Set("min_size", g.MinSize)
+	if g.MixedInstancesPolicy != nil {
+		if err := d.Set("mixed_instances_policy", []any{flattenMixedInstancesPolicy(g.MixedInstancesPolicy)}); err != nil {
+			return fmt.Errorf("setting mixed_instances_policy: %w", err)
+		}
+	} else {
+		d.Set("mixed_instances_policy", nil)
+	}
+	d.Set(names.AttrName, g.AutoScalingGroupName)
+	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(g.AutoScalingGroupName)))
+	d.Set("placement_group", g.PlacementGroup)
+	d.Set("predicted_capacity", g.PredictedCapacity)
+	d.Set("protect_from_scale_in", g.NewInstancesProtectedFromScaleIn)
+	d.Set("service_linked_role_arn", g.ServiceLinkedRoleARN)
+	d.Set("suspended_processes", flattenSuspendedProcesses(g.SuspendedProcesses))
+	if err := d.Set("traffic_source", flattenTrafficSourceIdentifiers(g.TrafficSources)); err != nil {
+		return fmt.Errorf("setting traffic_source: %w", err)
+	}
+	d.Set("target_group_arns", g.TargetGroupARNs)
+	// If no termination polices are explicitly configured and the upstream state
+	// is only using the "Default" policy, clear the state to make it consistent
+	// with the default AWS Create API behavior.
+	if _, ok := d.GetOk("termination_policies"); !ok && len(g.TerminationPolicies) == 1 && g.TerminationPolicies[0] == defaultTerminationPolicy {
+		d.Set("termination_policies", nil)
+	} else {
+		d.Set("termination_policies", g.TerminationPolicies)
+	}
+	if len(aws.ToString(g.VPCZoneIdentifier)) > 0 {
+		d.Set("vpc_zone_identifier", strings.Split(aws.ToString(g.VPCZoneIdentifier), ","))
+	} else {
+		d.Set("vpc_zone_identifier", nil)
+	}
+	if g.WarmPoolConfiguration != nil {
+		if err := d.Set("warm_pool", []any{flattenWarmPoolConfiguration(g.WarmPoolConfiguration)}); err != nil {
+			return fmt.Errorf("setting warm_pool: %w", err)
+		}
+	} else {
+		d.Set("warm_pool", nil)
+	}
+	d.Set("warm_pool_size", g.WarmPoolSize)
+
+	if err := d.Set("tag", listOfMap(keyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(client.IgnoreTagsConfig(ctx)))); err != nil {
+		return fmt.Errorf("setting tag: %w", err)
+	}
+
+	return nil
+}
+
 func findGroups(ctx context.Context, conn *autoscaling.Client, input *autoscaling.DescribeAutoScalingGroupsInput) ([]awstypes.AutoScalingGroup, error) {
 	var output []awstypes.AutoScalingGroup
 
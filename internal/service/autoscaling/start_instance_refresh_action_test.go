@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingStartInstanceRefreshAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, t, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_preferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_preferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, t, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func testAccStartInstanceRefreshActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, "t3.micro"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  name               = %[1]q
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+  force_delete       = true
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+}
+`, rName))
+}
+
+func testAccStartInstanceRefreshActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+
+  depends_on = [aws_autoscaling_group.test]
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_preferences(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+    timeout                = 1800
+
+    preferences {
+      min_healthy_percentage = 0
+      instance_warmup        = 0
+      checkpoint_percentages = [50, 100]
+      checkpoint_delay       = 0
+      skip_matching          = false
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+
+  depends_on = [aws_autoscaling_group.test]
+}
+`)
+}
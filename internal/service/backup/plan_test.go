@@ -59,6 +59,24 @@ func TestAccBackupPlan_basic(t *testing.T) {
 	})
 }
 
+func TestAccBackupPlan_invalidSchedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlanConfig_schedule(rName, "cron(0 12 * * ?)"),
+				ExpectError: regexache.MustCompile(`is an invalid schedule expression`),
+			},
+		},
+	})
+}
+
 func TestAccBackupPlan_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var plan backup.GetBackupPlanOutput
@@ -868,6 +886,24 @@ resource "aws_backup_plan" "test" {
 `, rName)
 }
 
+func testAccPlanConfig_schedule(rName, schedule string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name         = %[1]q
+    target_vault_name = aws_backup_vault.test.name
+    schedule          = %[2]q
+  }
+}
+`, rName, schedule)
+}
+
 func testAccPlanConfig_optInToArchiveForSupportedResources(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_backup_vault" "test" {
@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -67,7 +68,11 @@ func (r *restoreTestingPlanResource) Schema(ctx context.Context, request resourc
 				},
 			},
 			names.AttrScheduleExpression: schema.StringAttribute{
-				Required: true,
+				CustomType: fwtypes.ScheduleExpressionType,
+				Required:   true,
+				Validators: []validator.String{
+					validators.ScheduleExpression(schedule.KindCron),
+				},
 			},
 			"schedule_expression_timezone": schema.StringAttribute{
 				Computed: true,
@@ -336,7 +341,7 @@ type restoreTestingPlanResourceModel struct {
 	RecoveryPointSelection     fwtypes.ListNestedObjectValueOf[restoreRecoveryPointSelectionModel] `tfsdk:"recovery_point_selection"`
 	RestoreTestingPlanARN      types.String                                                        `tfsdk:"arn"`
 	RestoreTestingPlanName     types.String                                                        `tfsdk:"name"`
-	ScheduleExpression         types.String                                                        `tfsdk:"schedule_expression"`
+	ScheduleExpression         fwtypes.ScheduleExpression                                          `tfsdk:"schedule_expression"`
 	ScheduleExpressionTimezone types.String                                                        `tfsdk:"schedule_expression_timezone"`
 	StartWindowHours           types.Int64                                                         `tfsdk:"start_window_hours"`
 	Tags                       tftags.Map                                                          `tfsdk:"tags"`
@@ -252,6 +252,27 @@ func TestAccBackupRestoreTestingPlan_additionals(t *testing.T) {
 	})
 }
 
+func TestAccBackupRestoreTestingPlan_invalidScheduleExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := strings.ReplaceAll(acctest.RandomWithPrefix(t, acctest.ResourcePrefix), "-", "_")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingPlanDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRestoreTestingPlanConfig_additionals("365", "rate(1 day)", rName),
+				ExpectError: regexache.MustCompile(`value must be a valid cron\(\) schedule expression`),
+			},
+		},
+	})
+}
+
 func TestAccBackupRestoreTestingPlan_additionalsWithUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var restoretestingplan awstypes.RestoreTestingPlanForGet
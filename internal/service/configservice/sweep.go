@@ -20,12 +20,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_config_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_config_rule", &resource.Sweeper{
 		Name: "aws_config_config_rule",
 		F:    sweepConfigRules,
 		Dependencies: []string{
@@ -33,22 +33,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_conformance_pack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_conformance_pack", &resource.Sweeper{
 		Name: "aws_config_conformance_pack",
 		F:    sweepConformancePacks,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
 		Name: "aws_config_delivery_channel",
 		F:    sweepDeliveryChannels,
 		Dependencies: []string{
@@ -56,7 +56,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_remediation_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_remediation_configuration", &resource.Sweeper{
 		Name: "aws_config_remediation_configuration",
 		F:    sweepRemediationConfigurations,
 	})
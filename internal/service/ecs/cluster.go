@@ -7,6 +7,7 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -29,7 +31,11 @@ import (
 
 // @SDKResource("aws_ecs_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
 // @ArnFormat("cluster/{name}")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Cluster")
+// @Testing(preIdentityVersion="v6.33.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -246,26 +252,10 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading ECS Cluster (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, cluster.ClusterArn)
-	if cluster.Configuration != nil {
-		if err := d.Set(names.AttrConfiguration, flattenClusterConfiguration(cluster.Configuration)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
-		}
-	}
-	d.Set(names.AttrName, cluster.ClusterName)
-	if cluster.ServiceConnectDefaults != nil {
-		if err := d.Set("service_connect_defaults", []any{flattenClusterServiceConnectDefaults(cluster.ServiceConnectDefaults)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting service_connect_defaults: %s", err)
-		}
-	} else {
-		d.Set("service_connect_defaults", nil)
-	}
-	if err := d.Set("setting", flattenClusterSettings(cluster.Settings)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	if err := resourceClusterFlatten(ctx, cluster, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
 	}
 
-	setTagsOut(ctx, cluster.Tags)
-
 	return diags
 }
 
@@ -330,20 +320,53 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	d.Set(names.AttrName, d.Id())
+	// Clusters have always been imported by name.
+	if d.Id() != "" && !arn.IsARN(d.Id()) {
+		d.Set(names.AttrName, d.Id())
 
-	region := d.Get(names.AttrRegion).(string)
-	d.SetId(arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Service:   "ecs",
-		Resource:  "cluster/" + d.Id(),
-	}.String())
+		region := d.Get(names.AttrRegion).(string)
+		d.SetId(arn.ARN{
+			Partition: names.PartitionForRegion(region).ID(),
+			Region:    region,
+			AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+			Service:   "ecs",
+			Resource:  "cluster/" + d.Id(),
+		}.String())
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceClusterFlatten(ctx context.Context, cluster *awstypes.Cluster, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, cluster.ClusterArn)
+	if cluster.Configuration != nil {
+		if err := d.Set(names.AttrConfiguration, flattenClusterConfiguration(cluster.Configuration)); err != nil {
+			return fmt.Errorf("setting configuration: %w", err)
+		}
+	}
+	d.Set(names.AttrName, cluster.ClusterName)
+	if cluster.ServiceConnectDefaults != nil {
+		if err := d.Set("service_connect_defaults", []any{flattenClusterServiceConnectDefaults(cluster.ServiceConnectDefaults)}); err != nil {
+			return fmt.Errorf("setting service_connect_defaults: %w", err)
+		}
+	} else {
+		d.Set("service_connect_defaults", nil)
+	}
+	if err := d.Set("setting", flattenClusterSettings(cluster.Settings)); err != nil {
+		return fmt.Errorf("setting setting: %w", err)
+	}
+
+	setTagsOut(ctx, cluster.Tags)
+
+	return nil
+}
+
 func retryClusterCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error) {
 	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[any, *awstypes.InvalidParameterException](ctx, propagationTimeout, func(ctx context.Context) (any, error) {
 		return conn.CreateCluster(ctx, input)
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_ecs_cluster")
+func newClusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterListResource{}
+	l.SetResourceSchema(resourceCluster())
+
+	return &l
+}
+
+type clusterListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *clusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query clusterListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ecs.ListClustersInput
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for cluster, err := range listClusterDescriptions(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if aws.ToString(cluster.Status) == clusterStatusInactive {
+				continue
+			}
+
+			arn := aws.ToString(cluster.ClusterArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(arn)
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceClusterFlatten(ctx, &cluster, rd); err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(cluster.ClusterName)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// listClusterDescriptions describes each page of listed clusters.
+func listClusterDescriptions(ctx context.Context, conn *ecs.Client, input *ecs.ListClustersInput) iter.Seq2[awstypes.Cluster, error] {
+	return func(yield func(awstypes.Cluster, error) bool) {
+		pages := ecs.NewListClustersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Cluster{}, fmt.Errorf("listing ECS Clusters: %w", err))
+				return
+			}
+
+			if len(page.ClusterArns) == 0 {
+				continue
+			}
+
+			input := ecs.DescribeClustersInput{
+				Clusters: page.ClusterArns,
+				Include:  []awstypes.ClusterField{awstypes.ClusterFieldTags, awstypes.ClusterFieldConfigurations, awstypes.ClusterFieldSettings},
+			}
+			clusters, err := findClusters(ctx, conn, &input)
+			if err != nil {
+				yield(awstypes.Cluster{}, fmt.Errorf("describing ECS Clusters: %w", err))
+				return
+			}
+
+			for _, cluster := range clusters {
+				if !yield(cluster, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -24,7 +24,9 @@ var (
 	FindTag                                 = findTag
 	FindTaskDefinitionByFamilyOrARN         = findTaskDefinitionByFamilyOrARN
 	FindTaskSetNoTagsByThreePartKey         = findTaskSetNoTagsByThreePartKey
+	ForceNewDeploymentStatus                = forceNewDeploymentStatus
 	RoleNameFromARN                         = roleNameFromARN
+	RunTaskStatus                           = runTaskStatus
 	ServiceNameFromARN                      = serviceNameFromARN
 	TaskDefinitionARNStripRevision          = taskDefinitionARNStripRevision
 	ValidTaskDefinitionContainerDefinitions = validTaskDefinitionContainerDefinitions
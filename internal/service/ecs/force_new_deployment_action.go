@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// forceNewDeploymentPollInterval defines polling cadence for force new deployment action.
+	forceNewDeploymentPollInterval = 15 * time.Second
+
+	// Statuses of a forced deployment, derived from the service's deployments.
+	forceNewDeploymentStatusInProgress = actionwait.Status(awstypes.DeploymentRolloutStateInProgress)
+	forceNewDeploymentStatusCompleted  = actionwait.Status(awstypes.DeploymentRolloutStateCompleted)
+	forceNewDeploymentStatusFailed     = actionwait.Status(awstypes.DeploymentRolloutStateFailed)
+	forceNewDeploymentStatusReplaced   = actionwait.Status("REPLACED")
+)
+
+// @Action(aws_ecs_force_new_deployment, name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*forceNewDeploymentAction)(nil)
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentModel]
+}
+
+type forceNewDeploymentModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the service to reach a steady state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the ECS service",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config forceNewDeploymentModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	service := config.Service.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS force new deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Forcing new deployment of ECS service %s...", service),
+	})
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("Could not force new deployment of ECS service %s: %s", service, err),
+		)
+		return
+	}
+
+	primary := findPrimaryTaskSet(output.Service.Deployments)
+	if primary == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("ECS service %s has no primary deployment after update", service),
+		)
+		return
+	}
+	deploymentID := aws.ToString(primary.Id)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s started for ECS service %s, waiting for steady state...", deploymentID, service),
+	})
+
+	// Deployments replace tasks gradually, so poll at a fixed interval and report task counts.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Service], error) {
+		output, ferr := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, fmt.Errorf("describing service: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.Service]{Status: forceNewDeploymentStatus(output, deploymentID), Value: output}, nil
+	}, actionwait.Options[*awstypes.Service]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(forceNewDeploymentPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{forceNewDeploymentStatusCompleted},
+		TransitionalStates: []actionwait.Status{forceNewDeploymentStatusInProgress},
+		FailureStates:      []actionwait.Status{forceNewDeploymentStatusFailed, forceNewDeploymentStatusReplaced},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Deployment %s of ECS service %s is currently in state '%s'", deploymentID, service, fr.Status)
+			if v, ok := fr.Value.(*awstypes.Service); ok {
+				if deployment := findDeploymentByID(v.Deployments, deploymentID); deployment != nil {
+					message += fmt.Sprintf(", %d of %d tasks running", deployment.RunningCount, deployment.DesiredCount)
+				}
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message + ", continuing to wait..."})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s: %s", deploymentID, service, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := "deployment was replaced by another deployment"
+			if failureErr.Status == forceNewDeploymentStatusFailed {
+				reason = "deployment failed"
+				if fr.Value != nil {
+					if deployment := findDeploymentByID(fr.Value.Deployments, deploymentID); deployment != nil && aws.ToString(deployment.RolloutStateReason) != "" {
+						reason = aws.ToString(deployment.RolloutStateReason)
+					}
+				}
+			}
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				fmt.Sprintf("Deployment %s of ECS service %s did not reach a steady state: %s", deploymentID, service, reason),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s: %s", deploymentID, service, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s has reached a steady state", deploymentID, service),
+	})
+
+	tflog.Info(ctx, "ECS force new deployment action completed successfully", map[string]any{
+		"cluster":       cluster,
+		"service":       service,
+		"deployment_id": deploymentID,
+	})
+}
+
+// forceNewDeploymentStatus returns the status of the specified deployment of a service.
+// A deployment is complete once its rollout has completed or, for services which
+// don't report rollout state, once it is the only deployment and all of its tasks are running.
+func forceNewDeploymentStatus(service *awstypes.Service, deploymentID string) actionwait.Status {
+	deployment := findDeploymentByID(service.Deployments, deploymentID)
+	if deployment == nil || aws.ToString(deployment.Status) != taskSetStatusPrimary {
+		return forceNewDeploymentStatusReplaced
+	}
+
+	switch deployment.RolloutState {
+	case awstypes.DeploymentRolloutStateCompleted:
+		return forceNewDeploymentStatusCompleted
+	case awstypes.DeploymentRolloutStateFailed:
+		return forceNewDeploymentStatusFailed
+	case awstypes.DeploymentRolloutStateInProgress:
+		return forceNewDeploymentStatusInProgress
+	}
+
+	if len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount {
+		return forceNewDeploymentStatusCompleted
+	}
+
+	return forceNewDeploymentStatusInProgress
+}
+
+func findDeploymentByID(deployments []awstypes.Deployment, id string) *awstypes.Deployment {
+	for _, deployment := range deployments {
+		if aws.ToString(deployment.Id) == id {
+			return &deployment
+		}
+	}
+	return nil
+}
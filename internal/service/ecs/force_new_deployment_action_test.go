@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestForceNewDeploymentStatus(t *testing.T) {
+	t.Parallel()
+
+	const deploymentID = "ecs-svc/1234567890"
+
+	testCases := map[string]struct {
+		deployments []awstypes.Deployment
+		want        string
+	}{
+		"no deployment": {
+			want: "REPLACED",
+		},
+		"not primary": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("ACTIVE")},
+				{Id: aws.String("ecs-svc/0987654321"), Status: aws.String("PRIMARY")},
+			},
+			want: "REPLACED",
+		},
+		"rollout in progress": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateInProgress},
+			},
+			want: "IN_PROGRESS",
+		},
+		"rollout completed": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateCompleted},
+			},
+			want: "COMPLETED",
+		},
+		"rollout failed": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), RolloutState: awstypes.DeploymentRolloutStateFailed},
+			},
+			want: "FAILED",
+		},
+		"no rollout state draining": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), DesiredCount: 2, RunningCount: 2},
+				{Id: aws.String("ecs-svc/0987654321"), Status: aws.String("ACTIVE"), DesiredCount: 2, RunningCount: 1},
+			},
+			want: "IN_PROGRESS",
+		},
+		"no rollout state starting": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), DesiredCount: 2, RunningCount: 1},
+			},
+			want: "IN_PROGRESS",
+		},
+		"no rollout state steady": {
+			deployments: []awstypes.Deployment{
+				{Id: aws.String(deploymentID), Status: aws.String("PRIMARY"), DesiredCount: 2, RunningCount: 2},
+			},
+			want: "COMPLETED",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &awstypes.Service{Deployments: testCase.deployments}
+
+			if got, want := string(tfecs.ForceNewDeploymentStatus(service, deploymentID)), testCase.want; got != want {
+				t.Errorf("ForceNewDeploymentStatus() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECSForceNewDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var service awstypes.Service
+	resourceName := "aws_ecs_service.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForceNewDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, t, resourceName, &service),
+					testAccCheckForceNewDeploymentCompleted(ctx, t, resourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckForceNewDeploymentCompleted checks that the service has a single deployment with all of its tasks running.
+func testAccCheckForceNewDeploymentCompleted(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ECSClient(ctx)
+
+		output, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["cluster"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output.Deployments), 1; got != want {
+			return fmt.Errorf("ECS Service (%s) has %d deployments, want %d", rs.Primary.ID, got, want)
+		}
+
+		if deployment := output.Deployments[0]; deployment.RunningCount != deployment.DesiredCount {
+			return fmt.Errorf("ECS Service (%s) deployment %s has %d of %d tasks running", rs.Primary.ID, aws.ToString(deployment.Id), deployment.RunningCount, deployment.DesiredCount)
+		}
+
+		return nil
+	}
+}
+
+func testAccForceNewDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateAndWait(rName, 1, true), `
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+    timeout = 1800
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+
+  depends_on = [aws_ecs_service.test]
+}
+`)
+}
@@ -0,0 +1,511 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// runTaskPollInterval defines polling cadence for run task action.
+	runTaskPollInterval = 10 * time.Second
+
+	// Statuses of a stopped task, derived from the exit codes of its essential containers.
+	runTaskStatusSucceeded = actionwait.Status("SUCCEEDED")
+	runTaskStatusFailed    = actionwait.Status("FAILED")
+)
+
+// @Action(aws_ecs_run_task, name="Run Task")
+func newRunTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &runTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*runTaskAction)(nil)
+)
+
+type runTaskAction struct {
+	framework.ActionWithModel[runTaskModel]
+}
+
+type runTaskModel struct {
+	framework.WithRegionModel
+	Cluster              types.String                                                      `tfsdk:"cluster"`
+	Group                types.String                                                      `tfsdk:"group"`
+	LaunchType           fwtypes.StringEnum[awstypes.LaunchType]                           `tfsdk:"launch_type"`
+	NetworkConfiguration fwtypes.ListNestedObjectValueOf[runTaskNetworkConfigurationModel] `tfsdk:"network_configuration"`
+	Overrides            fwtypes.ListNestedObjectValueOf[runTaskOverridesModel]            `tfsdk:"overrides"`
+	PlatformVersion      types.String                                                      `tfsdk:"platform_version"`
+	StartedBy            types.String                                                      `tfsdk:"started_by"`
+	TaskDefinition       types.String                                                      `tfsdk:"task_definition"`
+	Timeout              types.Int64                                                       `tfsdk:"timeout"`
+}
+
+type runTaskNetworkConfigurationModel struct {
+	AssignPublicIP types.Bool          `tfsdk:"assign_public_ip"`
+	SecurityGroups fwtypes.SetOfString `tfsdk:"security_groups"`
+	Subnets        fwtypes.SetOfString `tfsdk:"subnets"`
+}
+
+type runTaskOverridesModel struct {
+	ContainerOverrides fwtypes.ListNestedObjectValueOf[runTaskContainerOverrideModel] `tfsdk:"container_override"`
+	CPU                types.String                                                   `tfsdk:"cpu"`
+	ExecutionRoleARN   types.String                                                   `tfsdk:"execution_role_arn"`
+	Memory             types.String                                                   `tfsdk:"memory"`
+	TaskRoleARN        types.String                                                   `tfsdk:"task_role_arn"`
+}
+
+type runTaskContainerOverrideModel struct {
+	Command     fwtypes.ListOfString `tfsdk:"command"`
+	CPU         types.Int64          `tfsdk:"cpu"`
+	Environment fwtypes.MapOfString  `tfsdk:"environment"`
+	Memory      types.Int64          `tfsdk:"memory"`
+	Name        types.String         `tfsdk:"name"`
+}
+
+func (a *runTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an ECS task and waits for it to stop. The action fails if any essential container exits with a non-zero exit code.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster",
+				Required:    true,
+			},
+			"group": schema.StringAttribute{
+				Description: "Task group to associate with the task",
+				Optional:    true,
+			},
+			"launch_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LaunchType](),
+				Description: "Launch type on which to run the task",
+				Optional:    true,
+			},
+			"platform_version": schema.StringAttribute{
+				Description: "Platform version the task uses",
+				Optional:    true,
+			},
+			"started_by": schema.StringAttribute{
+				Description: "Identifier for the task's starter",
+				Optional:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family and revision (family:revision), family or ARN of the task definition to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task to stop (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskNetworkConfigurationModel](ctx),
+				Description: "Network configuration for tasks using the awsvpc network mode",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"assign_public_ip": schema.BoolAttribute{
+							Description: "Whether to assign a public IP address to the task's elastic network interface",
+							Optional:    true,
+						},
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "Security groups associated with the task",
+							Optional:    true,
+						},
+						names.AttrSubnets: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "Subnets associated with the task",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"overrides": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskOverridesModel](ctx),
+				Description: "Overrides for the task definition",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cpu": schema.StringAttribute{
+							Description: "CPU override for the task",
+							Optional:    true,
+						},
+						names.AttrExecutionRoleARN: schema.StringAttribute{
+							Description: "ARN of the task execution role override",
+							Optional:    true,
+						},
+						"memory": schema.StringAttribute{
+							Description: "Memory override for the task",
+							Optional:    true,
+						},
+						"task_role_arn": schema.StringAttribute{
+							Description: "ARN of the task role override",
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"container_override": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskContainerOverrideModel](ctx),
+							Description: "Overrides for a container in the task definition",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"command": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Description: "Command to send to the container",
+										Optional:    true,
+									},
+									"cpu": schema.Int64Attribute{
+										Description: "Number of CPU units reserved for the container",
+										Optional:    true,
+									},
+									names.AttrEnvironment: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Description: "Environment variables to set in the container",
+										Optional:    true,
+									},
+									"memory": schema.Int64Attribute{
+										Description: "Hard limit in MiB of memory for the container",
+										Optional:    true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Description: "Name of the container",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *runTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runTaskModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	taskDefinition := config.TaskDefinition.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS run task action", map[string]any{
+		"cluster":         cluster,
+		"task_definition": taskDefinition,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running task from task definition %s in ECS cluster %s...", taskDefinition, cluster),
+	})
+
+	input, diags := expandRunTaskInput(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.RunTask(ctx, input)
+	if err == nil && len(output.Failures) > 0 {
+		err = errors.Join(tfslices.ApplyToAll(output.Failures, func(v awstypes.Failure) error {
+			return failureError(&v)
+		})...)
+	}
+	if err == nil && len(output.Tasks) == 0 {
+		err = tfresource.NewEmptyResultError()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Run Task",
+			fmt.Sprintf("Could not run task from task definition %s in ECS cluster %s: %s", taskDefinition, cluster, err),
+		)
+		return
+	}
+
+	task := output.Tasks[0]
+	taskARN := aws.ToString(task.TaskArn)
+
+	// Exit codes are only checked for essential containers, as a non-essential container stopping doesn't stop the task.
+	definition, _, err := findTaskDefinitionByFamilyOrARN(ctx, conn, aws.ToString(task.TaskDefinitionArn))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Task Definition",
+			fmt.Sprintf("Could not describe task definition %s: %s", aws.ToString(task.TaskDefinitionArn), err),
+		)
+		return
+	}
+	essential := essentialContainerNames(definition)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s started, waiting for it to stop...", taskARN),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Task], error) {
+		task, ferr := findTaskByTwoPartKey(ctx, conn, taskARN, cluster)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.Task]{}, fmt.Errorf("describing task: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.Task]{Status: runTaskStatus(task, essential), Value: task}, nil
+	}, actionwait.Options[*awstypes.Task]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(runTaskPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{runTaskStatusSucceeded},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(taskStatusProvisioning),
+			actionwait.Status(taskStatusPending),
+			actionwait.Status(taskStatusActivating),
+			actionwait.Status(taskStatusRunning),
+			actionwait.Status(taskStatusDeactivating),
+			actionwait.Status(taskStatusStopping),
+			actionwait.Status(taskStatusDeprovisioning),
+		},
+		FailureStates: []actionwait.Status{runTaskStatusFailed},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Task %s is currently in state '%s', continuing to wait for it to stop...", taskARN, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Task",
+				fmt.Sprintf("Task %s did not stop within %s: %s", taskARN, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Task Failed",
+				fmt.Sprintf("Task %s stopped with failed essential containers: %s", taskARN, strings.Join(runTaskFailureReasons(fr.Value, essential), "; ")),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Task State",
+				fmt.Sprintf("Task %s entered unexpected state: %s", taskARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Task",
+				fmt.Sprintf("Error while waiting for task %s to stop: %s", taskARN, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s stopped and all essential containers exited successfully", taskARN),
+	})
+
+	tflog.Info(ctx, "ECS run task action completed successfully", map[string]any{
+		"cluster":  cluster,
+		"task_arn": taskARN,
+	})
+}
+
+func findTaskByTwoPartKey(ctx context.Context, conn *ecs.Client, taskARN, clusterNameOrARN string) (*awstypes.Task, error) {
+	input := ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   []string{taskARN},
+	}
+
+	output, err := conn.DescribeTasks(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return tfresource.AssertSingleValueResult(output.Tasks)
+}
+
+// runTaskStatus returns the status of a task.
+// Once the task has stopped, it has succeeded only if every essential container exited with code 0.
+func runTaskStatus(task *awstypes.Task, essential map[string]bool) actionwait.Status {
+	if status := aws.ToString(task.LastStatus); status != taskStatusStopped {
+		return actionwait.Status(status)
+	}
+
+	if len(runTaskFailureReasons(task, essential)) > 0 {
+		return runTaskStatusFailed
+	}
+
+	return runTaskStatusSucceeded
+}
+
+// runTaskFailureReasons describes the essential containers of a stopped task which didn't exit with code 0.
+func runTaskFailureReasons(task *awstypes.Task, essential map[string]bool) []string {
+	var reasons []string
+
+	if task == nil {
+		return reasons
+	}
+
+	for _, container := range task.Containers {
+		name := aws.ToString(container.Name)
+		// Containers not in the task definition, such as injected sidecars, are not essential.
+		if !essential[name] {
+			continue
+		}
+
+		switch exitCode := container.ExitCode; {
+		case exitCode == nil:
+			reasons = append(reasons, fmt.Sprintf("container %s did not exit (%s)", name, runTaskReason(container.Reason, task.StoppedReason)))
+		case aws.ToInt32(exitCode) != 0:
+			reasons = append(reasons, fmt.Sprintf("container %s exited with code %d (%s)", name, aws.ToInt32(exitCode), runTaskReason(container.Reason, task.StoppedReason)))
+		}
+	}
+
+	return reasons
+}
+
+func runTaskReason(containerReason, stoppedReason *string) string {
+	if v := aws.ToString(containerReason); v != "" {
+		return v
+	}
+	if v := aws.ToString(stoppedReason); v != "" {
+		return v
+	}
+	return "no reason given"
+}
+
+// essentialContainerNames returns whether each container in the task definition is essential.
+// Containers are essential unless explicitly marked otherwise.
+func essentialContainerNames(definition *awstypes.TaskDefinition) map[string]bool {
+	essential := make(map[string]bool, len(definition.ContainerDefinitions))
+
+	for _, container := range definition.ContainerDefinitions {
+		essential[aws.ToString(container.Name)] = container.Essential == nil || aws.ToBool(container.Essential)
+	}
+
+	return essential
+}
+
+func expandRunTaskInput(ctx context.Context, config *runTaskModel) (*ecs.RunTaskInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &ecs.RunTaskInput{
+		Cluster:         fwflex.StringFromFramework(ctx, config.Cluster),
+		Group:           fwflex.StringFromFramework(ctx, config.Group),
+		LaunchType:      config.LaunchType.ValueEnum(),
+		PlatformVersion: fwflex.StringFromFramework(ctx, config.PlatformVersion),
+		StartedBy:       fwflex.StringFromFramework(ctx, config.StartedBy),
+		TaskDefinition:  fwflex.StringFromFramework(ctx, config.TaskDefinition),
+	}
+
+	networkConfiguration, d := config.NetworkConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if networkConfiguration != nil {
+		assignPublicIP := awstypes.AssignPublicIpDisabled
+		if networkConfiguration.AssignPublicIP.ValueBool() {
+			assignPublicIP = awstypes.AssignPublicIpEnabled
+		}
+
+		input.NetworkConfiguration = &awstypes.NetworkConfiguration{
+			AwsvpcConfiguration: &awstypes.AwsVpcConfiguration{
+				AssignPublicIp: assignPublicIP,
+				SecurityGroups: fwflex.ExpandFrameworkStringValueSet(ctx, networkConfiguration.SecurityGroups),
+				Subnets:        fwflex.ExpandFrameworkStringValueSet(ctx, networkConfiguration.Subnets),
+			},
+		}
+	}
+
+	overrides, d := config.Overrides.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if overrides != nil {
+		input.Overrides = &awstypes.TaskOverride{
+			Cpu:              fwflex.StringFromFramework(ctx, overrides.CPU),
+			ExecutionRoleArn: fwflex.StringFromFramework(ctx, overrides.ExecutionRoleARN),
+			Memory:           fwflex.StringFromFramework(ctx, overrides.Memory),
+			TaskRoleArn:      fwflex.StringFromFramework(ctx, overrides.TaskRoleARN),
+		}
+
+		containerOverrides, d := overrides.ContainerOverrides.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for _, containerOverride := range containerOverrides {
+			apiObject := awstypes.ContainerOverride{
+				Command: fwflex.ExpandFrameworkStringValueList(ctx, containerOverride.Command),
+				Cpu:     fwflex.Int32FromFrameworkInt64(ctx, containerOverride.CPU),
+				Memory:  fwflex.Int32FromFrameworkInt64(ctx, containerOverride.Memory),
+				Name:    fwflex.StringFromFramework(ctx, containerOverride.Name),
+			}
+
+			environment := fwflex.ExpandFrameworkStringValueMap(ctx, containerOverride.Environment)
+			for _, k := range slices.Sorted(maps.Keys(environment)) {
+				apiObject.Environment = append(apiObject.Environment, awstypes.KeyValuePair{
+					Name:  aws.String(k),
+					Value: aws.String(environment[k]),
+				})
+			}
+
+			input.Overrides.ContainerOverrides = append(input.Overrides.ContainerOverrides, apiObject)
+		}
+	}
+
+	return input, diags
+}
@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRunTaskStatus(t *testing.T) {
+	t.Parallel()
+
+	essential := map[string]bool{
+		"app":     true,
+		"sidecar": false,
+	}
+
+	testCases := map[string]struct {
+		task *awstypes.Task
+		want string
+	}{
+		"running": {
+			task: &awstypes.Task{
+				LastStatus: aws.String("RUNNING"),
+				Containers: []awstypes.Container{
+					{Name: aws.String("app")},
+				},
+			},
+			want: "RUNNING",
+		},
+		"succeeded": {
+			task: &awstypes.Task{
+				LastStatus: aws.String("STOPPED"),
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(0)},
+					{Name: aws.String("sidecar"), ExitCode: aws.Int32(137)},
+				},
+			},
+			want: "SUCCEEDED",
+		},
+		"essential container non-zero exit code": {
+			task: &awstypes.Task{
+				LastStatus: aws.String("STOPPED"),
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(1)},
+				},
+			},
+			want: "FAILED",
+		},
+		"essential container no exit code": {
+			task: &awstypes.Task{
+				LastStatus:    aws.String("STOPPED"),
+				StoppedReason: aws.String("CannotPullContainerError"),
+				Containers: []awstypes.Container{
+					{Name: aws.String("app")},
+				},
+			},
+			want: "FAILED",
+		},
+		"unknown container is not essential": {
+			task: &awstypes.Task{
+				LastStatus: aws.String("STOPPED"),
+				Containers: []awstypes.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int32(0)},
+					{Name: aws.String("other"), ExitCode: aws.Int32(2)},
+				},
+			},
+			want: "SUCCEEDED",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := string(tfecs.RunTaskStatus(testCase.task, essential)), testCase.want; got != want {
+				t.Errorf("RunTaskStatus() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECSRunTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_basic(rName),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_overrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_overrides(rName, `["sh", "-c", "test \"$EXPECTED\" = ok"]`),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_nonZeroExitCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_overrides(rName, `["sh", "-c", "exit 3"]`),
+				ExpectError: regexache.MustCompile(`container test exited with code 3`),
+			},
+		},
+	})
+}
+
+func testAccRunTaskActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "run" {
+  family                   = "%[1]s-run"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([
+    {
+      name      = "test"
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      command   = ["true"]
+      essential = true
+    }
+  ])
+}
+`, rName))
+}
+
+func testAccRunTaskActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), `
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.run.arn
+    launch_type     = "FARGATE"
+
+    network_configuration {
+      security_groups  = aws_security_group.test[*].id
+      subnets          = aws_subnet.test[*].id
+      assign_public_ip = true
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_ecs_task_definition.run, aws_route_table_association.test]
+}
+`)
+}
+
+func testAccRunTaskActionConfig_overrides(rName, command string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), fmt.Sprintf(`
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.run.arn
+    launch_type     = "FARGATE"
+    started_by      = %[1]q
+    timeout         = 1200
+
+    network_configuration {
+      security_groups  = aws_security_group.test[*].id
+      subnets          = aws_subnet.test[*].id
+      assign_public_ip = true
+    }
+
+    overrides {
+      container_override {
+        name    = "test"
+        command = %[2]s
+
+        environment = {
+          EXPECTED = "ok"
+        }
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_ecs_task_definition.run, aws_route_table_association.test]
+}
+`, rName, command))
+}
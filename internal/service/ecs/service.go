@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
@@ -48,6 +49,10 @@ import (
 
 // @SDKResource("aws_ecs_service", name="Service")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Service")
+// @Testing(preIdentityVersion="v6.33.0")
 func resourceService() *schema.Resource {
 	// Resource with v0 schema (provider v5.58.0).
 	resourceV0 := &schema.Resource{
@@ -1581,111 +1586,9 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading ECS Service (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, service.ServiceArn)
-	d.Set("availability_zone_rebalancing", service.AvailabilityZoneRebalancing)
-	if err := d.Set(names.AttrCapacityProviderStrategy, flattenCapacityProviderStrategyItems(service.CapacityProviderStrategy)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting capacity_provider_strategy: %s", err)
+	if err := resourceServiceFlatten(ctx, service, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
 	}
-	// Save cluster in the same format.
-	if arn.IsARN(cluster) {
-		d.Set("cluster", service.ClusterArn)
-	} else {
-		d.Set("cluster", clusterNameFromARN(aws.ToString(service.ClusterArn)))
-	}
-	if service.DeploymentConfiguration != nil {
-		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
-		d.Set("deployment_minimum_healthy_percent", service.DeploymentConfiguration.MinimumHealthyPercent)
-
-		if service.DeploymentConfiguration.Alarms != nil {
-			if err := d.Set("alarms", []any{flattenAlarms(service.DeploymentConfiguration.Alarms)}); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting alarms: %s", err)
-			}
-		} else {
-			d.Set("alarms", nil)
-		}
-
-		if service.DeploymentConfiguration.DeploymentCircuitBreaker != nil {
-			if err := d.Set("deployment_circuit_breaker", []any{flattenDeploymentCircuitBreaker(service.DeploymentConfiguration.DeploymentCircuitBreaker)}); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting deployment_circuit_breaker: %s", err)
-			}
-		} else {
-			d.Set("deployment_circuit_breaker", nil)
-		}
-
-		if err := d.Set("deployment_configuration", flattenDeploymentConfiguration(service.DeploymentConfiguration)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting deployment_configuration: %s", err)
-		}
-	}
-	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting deployment_controller: %s", err)
-	}
-	d.Set("desired_count", service.DesiredCount)
-	d.Set("enable_execute_command", service.EnableExecuteCommand)
-	d.Set("enable_ecs_managed_tags", service.EnableECSManagedTags)
-	d.Set("health_check_grace_period_seconds", service.HealthCheckGracePeriodSeconds)
-	// Save IAM role in the same format.
-	if service.RoleArn != nil {
-		if arn.IsARN(d.Get("iam_role").(string)) {
-			d.Set("iam_role", service.RoleArn)
-		} else {
-			d.Set("iam_role", roleNameFromARN(aws.ToString(service.RoleArn)))
-		}
-	}
-	d.Set("launch_type", service.LaunchType)
-	if service.LoadBalancers != nil {
-		if err := d.Set("load_balancer", flattenServiceLoadBalancers(service.LoadBalancers)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting load_balancer: %s", err)
-		}
-	}
-	d.Set(names.AttrName, service.ServiceName)
-	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(service.NetworkConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
-	}
-	if err := d.Set("ordered_placement_strategy", flattenPlacementStrategy(service.PlacementStrategy)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ordered_placement_strategy: %s", err)
-	}
-	if err := d.Set("placement_constraints", flattenServicePlacementConstraints(service.PlacementConstraints)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting placement_constraints: %s", err)
-	}
-	d.Set("platform_version", service.PlatformVersion)
-	d.Set(names.AttrPropagateTags, service.PropagateTags)
-	d.Set("scheduling_strategy", service.SchedulingStrategy)
-	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
-	}
-	// When creating a service that uses the EXTERNAL deployment controller,
-	// you can specify only parameters that aren't controlled at the task set level
-	// hence TaskDefinition will not be set by aws sdk
-	if service.TaskDefinition != nil {
-		// Save task definition in the same format.
-		if arn.IsARN(d.Get("task_definition").(string)) {
-			d.Set("task_definition", service.TaskDefinition)
-		} else {
-			d.Set("task_definition", familyAndRevisionFromTaskDefinitionARN(aws.ToString(service.TaskDefinition)))
-		}
-	}
-	d.Set(names.AttrTriggers, d.Get(names.AttrTriggers))
-	for _, deployment := range service.Deployments {
-		if aws.ToString(deployment.Status) == "PRIMARY" {
-			if v := deployment.ServiceConnectConfiguration; v != nil {
-				if err := d.Set("service_connect_configuration", flattenServiceConnectConfiguration(v)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "setting service_connect_configuration: %s", err)
-				}
-			} else {
-				d.Set("service_connect_configuration", nil)
-			}
-			if v := deployment.VolumeConfigurations; len(v) > 0 {
-				if err := d.Set("volume_configuration", flattenServiceVolumeConfigurations(ctx, v)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "setting volume_configurations: %s", err)
-				}
-			}
-			if err := d.Set("vpc_lattice_configurations", flattenVPCLatticeConfigurations(deployment.VpcLatticeConfigurations)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting vpc_lattice_configurations: %s", err)
-			}
-		}
-	}
-
-	setTagsOut(ctx, service.Tags)
 
 	return diags
 }
@@ -2011,6 +1914,16 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" || arn.IsARN(d.Id()) {
+		if err := importer.Import(ctx, d, meta); err != nil {
+			return nil, err
+		}
+
+		d.Set("cluster", clusterARNFromServiceARN(d.Id()))
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
@@ -2043,6 +1956,116 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceServiceFlatten(ctx context.Context, service *awstypes.Service, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, service.ServiceArn)
+	d.Set("availability_zone_rebalancing", service.AvailabilityZoneRebalancing)
+	if err := d.Set(names.AttrCapacityProviderStrategy, flattenCapacityProviderStrategyItems(service.CapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("setting capacity_provider_strategy: %w", err)
+	}
+	// Save cluster in the same format.
+	if arn.IsARN(d.Get("cluster").(string)) {
+		d.Set("cluster", service.ClusterArn)
+	} else {
+		d.Set("cluster", clusterNameFromARN(aws.ToString(service.ClusterArn)))
+	}
+	if service.DeploymentConfiguration != nil {
+		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
+		d.Set("deployment_minimum_healthy_percent", service.DeploymentConfiguration.MinimumHealthyPercent)
+
+		if service.DeploymentConfiguration.Alarms != nil {
+			if err := d.Set("alarms", []any{flattenAlarms(service.DeploymentConfiguration.Alarms)}); err != nil {
+				return fmt.Errorf("setting alarms: %w", err)
+			}
+		} else {
+			d.Set("alarms", nil)
+		}
+
+		if service.DeploymentConfiguration.DeploymentCircuitBreaker != nil {
+			if err := d.Set("deployment_circuit_breaker", []any{flattenDeploymentCircuitBreaker(service.DeploymentConfiguration.DeploymentCircuitBreaker)}); err != nil {
+				return fmt.Errorf("setting deployment_circuit_breaker: %w", err)
+			}
+		} else {
+			d.Set("deployment_circuit_breaker", nil)
+		}
+
+		if err := d.Set("deployment_configuration", flattenDeploymentConfiguration(service.DeploymentConfiguration)); err != nil {
+			return fmt.Errorf("setting deployment_configuration: %w", err)
+		}
+	}
+	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
+		return fmt.Errorf("setting deployment_controller: %w", err)
+	}
+	d.Set("desired_count", service.DesiredCount)
+	d.Set("enable_execute_command", service.EnableExecuteCommand)
+	d.Set("enable_ecs_managed_tags", service.EnableECSManagedTags)
+	d.Set("health_check_grace_period_seconds", service.HealthCheckGracePeriodSeconds)
+	// Save IAM role in the same format.
+	if service.RoleArn != nil {
+		if arn.IsARN(d.Get("iam_role").(string)) {
+			d.Set("iam_role", service.RoleArn)
+		} else {
+			d.Set("iam_role", roleNameFromARN(aws.ToString(service.RoleArn)))
+		}
+	}
+	d.Set("launch_type", service.LaunchType)
+	if service.LoadBalancers != nil {
+		if err := d.Set("load_balancer", flattenServiceLoadBalancers(service.LoadBalancers)); err != nil {
+			return fmt.Errorf("setting load_balancer: %w", err)
+		}
+	}
+	d.Set(names.AttrName, service.ServiceName)
+	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(service.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("setting network_configuration: %w", err)
+	}
+	if err := d.Set("ordered_placement_strategy", flattenPlacementStrategy(service.PlacementStrategy)); err != nil {
+		return fmt.Errorf("setting ordered_placement_strategy: %w", err)
+	}
+	if err := d.Set("placement_constraints", flattenServicePlacementConstraints(service.PlacementConstraints)); err != nil {
+		return fmt.Errorf("setting placement_constraints: %w", err)
+	}
+	d.Set("platform_version", service.PlatformVersion)
+	d.Set(names.AttrPropagateTags, service.PropagateTags)
+	d.Set("scheduling_strategy", service.SchedulingStrategy)
+	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
+		return fmt.Errorf("setting service_registries: %w", err)
+	}
+	// When creating a service that uses the EXTERNAL deployment controller,
+	// you can specify only parameters that aren't controlled at the task set level
+	// hence TaskDefinition will not be set by aws sdk
+	if service.TaskDefinition != nil {
+		// Save task definition in the same format.
+		if arn.IsARN(d.Get("task_definition").(string)) {
+			d.Set("task_definition", service.TaskDefinition)
+		} else {
+			d.Set("task_definition", familyAndRevisionFromTaskDefinitionARN(aws.ToString(service.TaskDefinition)))
+		}
+	}
+	d.Set(names.AttrTriggers, d.Get(names.AttrTriggers))
+	for _, deployment := range service.Deployments {
+		if aws.ToString(deployment.Status) == "PRIMARY" {
+			if v := deployment.ServiceConnectConfiguration; v != nil {
+				if err := d.Set("service_connect_configuration", flattenServiceConnectConfiguration(v)); err != nil {
+					return fmt.Errorf("setting service_connect_configuration: %w", err)
+				}
+			} else {
+				d.Set("service_connect_configuration", nil)
+			}
+			if v := deployment.VolumeConfigurations; len(v) > 0 {
+				if err := d.Set("volume_configuration", flattenServiceVolumeConfigurations(ctx, v)); err != nil {
+					return fmt.Errorf("setting volume_configurations: %w", err)
+				}
+			}
+			if err := d.Set("vpc_lattice_configurations", flattenVPCLatticeConfigurations(deployment.VpcLatticeConfigurations)); err != nil {
+				return fmt.Errorf("setting vpc_lattice_configurations: %w", err)
+			}
+		}
+	}
+
+	setTagsOut(ctx, service.Tags)
+
+	return nil
+}
+
 func retryServiceCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
 	const (
 		serviceCreateTimeout = 2 * time.Minute
@@ -3880,6 +3903,22 @@ func clusterNameFromARN(arn string) string {
 	return ""
 }
 
+// clusterARNFromServiceARN returns the ARN of the cluster from a long format service ARN.
+func clusterARNFromServiceARN(s string) string {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+
+	// long arn format arn:aws:ecs:us-west-2:123456789:service/cluster_name/service_name
+	if resParts := strings.Split(a.Resource, "/"); len(resParts) == 3 {
+		a.Resource = "cluster/" + resParts[1]
+		return a.String()
+	}
+
+	return ""
+}
+
 func serviceNameFromARN(s string) string {
 	a, err := arn.Parse(s)
 	if err != nil {
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_ecs_service")
+func newServiceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := serviceListResource{}
+	l.SetResourceSchema(resourceService())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &serviceListResource{}
+
+type serviceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type serviceListResourceModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+}
+
+func (l *serviceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"cluster": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name or ARN of the ECS cluster to list services from. Defaults to all clusters.",
+			},
+		},
+	}
+}
+
+func (l *serviceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query serviceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var clusters []string
+		if v := query.Cluster.ValueString(); v != "" {
+			clusters = []string{v}
+		} else {
+			var input ecs.ListClustersInput
+			output, err := listClusters(ctx, conn, &input)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing ECS Clusters: %w", err))
+				yield(result)
+				return
+			}
+			clusters = output
+		}
+
+		for _, cluster := range clusters {
+			input := ecs.ListServicesInput{
+				Cluster: aws.String(cluster),
+			}
+			for service, err := range listServiceDescriptions(ctx, conn, &input) {
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				if aws.ToString(service.Status) == serviceStatusInactive {
+					continue
+				}
+
+				arn := aws.ToString(service.ServiceArn)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set("cluster", service.ClusterArn)
+
+				tflog.Info(ctx, "Reading resource")
+				if err := resourceServiceFlatten(ctx, &service, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(service.ServiceName), clusterNameFromARN(aws.ToString(service.ClusterArn)))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// listServiceDescriptions describes each page of services listed in a cluster.
+func listServiceDescriptions(ctx context.Context, conn *ecs.Client, input *ecs.ListServicesInput) iter.Seq2[awstypes.Service, error] {
+	return func(yield func(awstypes.Service, error) bool) {
+		pages := ecs.NewListServicesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Service{}, fmt.Errorf("listing ECS Services (%s): %w", aws.ToString(input.Cluster), err))
+				return
+			}
+
+			if len(page.ServiceArns) == 0 {
+				continue
+			}
+
+			// DescribeServices accepts at most 10 services, the default ListServices page size.
+			// Services deleted since being listed are returned as failures and skipped.
+			describeInput := ecs.DescribeServicesInput{
+				Cluster:  input.Cluster,
+				Include:  []awstypes.ServiceField{awstypes.ServiceFieldTags},
+				Services: page.ServiceArns,
+			}
+			output, err := conn.DescribeServices(ctx, &describeInput)
+			if err != nil {
+				yield(awstypes.Service{}, fmt.Errorf("describing ECS Services (%s): %w", aws.ToString(input.Cluster), err))
+				return
+			}
+
+			for _, service := range output.Services {
+				if !yield(service, nil) {
+					return
+				}
+			}
+		}
+	}
+}
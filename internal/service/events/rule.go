@@ -30,6 +30,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -122,9 +123,12 @@ func resourceRule() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrScheduleExpression: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 256),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 256),
+					verify.ValidScheduleExpression(schedule.KindCron, schedule.KindRate),
+				),
 				AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
 			},
 			names.AttrState: {
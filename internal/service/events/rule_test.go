@@ -357,6 +357,24 @@ func TestAccEventsRule_role(t *testing.T) {
 	})
 }
 
+func TestAccEventsRule_invalidScheduleExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_scheduleExpression(rName, "cron(0 12 * * * *)"),
+				ExpectError: regexache.MustCompile(`is an invalid schedule expression`),
+			},
+		},
+	})
+}
+
 func TestAccEventsRule_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2 eventbridge.DescribeRuleOutput
@@ -1021,6 +1039,15 @@ resource "aws_cloudwatch_event_rule" "test" {
 `, rName)
 }
 
+func testAccRuleConfig_scheduleExpression(rName, scheduleExpression string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = %[2]q
+}
+`, rName, scheduleExpression)
+}
+
 func testAccRuleConfig_defaultBusName(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -273,6 +273,24 @@ func TestAccGlueTrigger_schedule(t *testing.T) {
 	})
 }
 
+func TestAccGlueTrigger_invalidSchedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTriggerConfig_schedule(rName, "rate(1 day)"),
+				ExpectError: regexache.MustCompile(`is an invalid schedule expression`),
+			},
+		},
+	})
+}
+
 func TestAccGlueTrigger_startOnCreate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var trigger awstypes.Trigger
@@ -18,6 +18,7 @@ var (
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
+	UnhealthyAlarms                              = unhealthyAlarms
 	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
 	FindCapacityProviderByName                   = findCapacityProviderByName
 	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
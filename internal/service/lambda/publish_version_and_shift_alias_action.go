@@ -0,0 +1,449 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// shiftAliasAlarmPollInterval is how often alarms are checked while traffic is held at a step.
+	shiftAliasAlarmPollInterval = 15 * time.Second
+)
+
+// @Action(aws_lambda_publish_version_and_shift_alias, name="Publish Version and Shift Alias")
+func newPublishVersionAndShiftAliasAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &publishVersionAndShiftAliasAction{}, nil
+}
+
+var (
+	_ action.Action = (*publishVersionAndShiftAliasAction)(nil)
+)
+
+type publishVersionAndShiftAliasAction struct {
+	framework.ActionWithModel[publishVersionAndShiftAliasActionModel]
+}
+
+type publishVersionAndShiftAliasActionModel struct {
+	framework.WithRegionModel
+	AlarmNames            fwtypes.ListOfString `tfsdk:"alarm_names"`
+	AliasName             types.String         `tfsdk:"alias_name"`
+	AllowInsufficientData types.Bool           `tfsdk:"allow_insufficient_data"`
+	CodeSHA256            types.String         `tfsdk:"code_sha256"`
+	Description           types.String         `tfsdk:"description"`
+	FunctionName          types.String         `tfsdk:"function_name"`
+	StepInterval          types.Int64          `tfsdk:"step_interval"`
+	Timeout               types.Int64          `tfsdk:"timeout"`
+	TrafficPercentages    fwtypes.ListOfInt64  `tfsdk:"traffic_percentages"`
+}
+
+func (a *publishVersionAndShiftAliasAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publishes a new version of an AWS Lambda function and shifts an alias's traffic to it in steps, rolling back if a CloudWatch alarm leaves the OK state.",
+		Attributes: map[string]schema.Attribute{
+			"alarm_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "Names of CloudWatch metric or composite alarms that must stay in the OK state while traffic is shifted. If any alarm leaves the OK state, the alias is rolled back to the previous version",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Description: "Name of the alias whose traffic is shifted to the new version",
+				Required:    true,
+			},
+			"allow_insufficient_data": schema.BoolAttribute{
+				Description: "Whether alarms in the INSUFFICIENT_DATA state are treated as healthy (default: false)",
+				Optional:    true,
+			},
+			"code_sha256": schema.StringAttribute{
+				Description: "SHA256 hash of the function's deployment package. The version is only published if the hash matches the function's current code",
+				Optional:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "Description of the published version",
+				Optional:    true,
+			},
+			"function_name": schema.StringAttribute{
+				Description: "Name or ARN of the Lambda function",
+				Required:    true,
+			},
+			"step_interval": schema.Int64Attribute{
+				Description: "Number of seconds to hold traffic at each step while monitoring alarms (default: 300)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds for the whole deployment (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+			"traffic_percentages": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfInt64Type,
+				ElementType: types.Int64Type,
+				Description: "Percentages of the alias's traffic to route to the new version at each step, in ascending order. After the last step, all traffic is routed to the new version (default: [10])",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(1, 99)),
+				},
+			},
+		},
+	}
+}
+
+func (a *publishVersionAndShiftAliasAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config publishVersionAndShiftAliasActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS clients
+	conn := a.Meta().LambdaClient(ctx)
+	cloudwatchConn := a.Meta().CloudWatchClient(ctx)
+
+	functionName := config.FunctionName.ValueString()
+	aliasName := config.AliasName.ValueString()
+	alarmNames := fwflex.ExpandFrameworkStringValueList(ctx, config.AlarmNames)
+	allowInsufficientData := config.AllowInsufficientData.ValueBool()
+
+	// Set default values for optional parameters
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	stepInterval := 5 * time.Minute
+	if !config.StepInterval.IsNull() {
+		stepInterval = time.Duration(config.StepInterval.ValueInt64()) * time.Second
+	}
+
+	percentages := []int64{10}
+	if !config.TrafficPercentages.IsNull() {
+		resp.Diagnostics.Append(config.TrafficPercentages.ElementsAs(ctx, &percentages, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for i := 1; i < len(percentages); i++ {
+		if percentages[i] <= percentages[i-1] {
+			resp.Diagnostics.AddError(
+				"Invalid Traffic Percentages",
+				fmt.Sprintf("traffic_percentages must be in strictly ascending order, got %v", percentages),
+			)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Starting Lambda publish version and shift alias action", map[string]any{
+		"function_name":       functionName,
+		"alias_name":          aliasName,
+		"alarm_names":         alarmNames,
+		"step_interval":       stepInterval.String(),
+		"traffic_percentages": percentages,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	alias, err := findAliasByTwoPartKey(ctx, conn, functionName, aliasName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Lambda Alias",
+			fmt.Sprintf("Could not read alias %s of Lambda function %s: %s", aliasName, functionName, err),
+		)
+		return
+	}
+
+	previousVersion := aws.ToString(alias.FunctionVersion)
+
+	// Don't interfere with a deployment that is already shifting traffic.
+	if alias.RoutingConfig != nil && len(alias.RoutingConfig.AdditionalVersionWeights) > 0 {
+		resp.Diagnostics.AddError(
+			"Lambda Alias Has Weighted Routing",
+			fmt.Sprintf("Alias %s of Lambda function %s already routes traffic to additional versions %v. Complete or roll back that deployment first.", aliasName, functionName, alias.RoutingConfig.AdditionalVersionWeights),
+		)
+		return
+	}
+
+	// Refuse to start shifting traffic if an alarm is already unhealthy.
+	if len(alarmNames) > 0 {
+		metricAlarms, compositeAlarms, err := findAlarmsByNames(ctx, cloudwatchConn, alarmNames)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Describe CloudWatch Alarms",
+				fmt.Sprintf("Could not read CloudWatch alarms %v: %s", alarmNames, err),
+			)
+			return
+		}
+
+		if firing := unhealthyAlarms(metricAlarms, compositeAlarms, allowInsufficientData); len(firing) > 0 {
+			resp.Diagnostics.AddError(
+				"CloudWatch Alarm Not In OK State",
+				fmt.Sprintf("Not shifting traffic on alias %s of Lambda function %s because alarms are not in the OK state: %s", aliasName, functionName, strings.Join(firing, ", ")),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Publishing new version of Lambda function %s...", functionName),
+	})
+
+	input := lambda.PublishVersionInput{
+		CodeSha256:   fwflex.StringFromFramework(ctx, config.CodeSHA256),
+		Description:  fwflex.StringFromFramework(ctx, config.Description),
+		FunctionName: aws.String(functionName),
+	}
+
+	output, err := tfresource.RetryWhenIsAErrorMessageContains[*lambda.PublishVersionOutput, *awstypes.ResourceConflictException](ctx, lambdaPropagationTimeout, func(ctx context.Context) (*lambda.PublishVersionOutput, error) {
+		return conn.PublishVersion(ctx, &input)
+	}, "in progress")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Publish Lambda Function Version",
+			fmt.Sprintf("Could not publish a version of Lambda function %s: %s", functionName, err),
+		)
+		return
+	}
+
+	version := aws.ToString(output.Version)
+
+	if _, err := waitFunctionConfigurationUpdated(ctx, conn, aws.ToString(output.FunctionArn), version, timeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Lambda Function Version",
+			fmt.Sprintf("Error while waiting for version %s of Lambda function %s to become ready: %s", version, functionName, err),
+		)
+		return
+	}
+
+	// Publishing unchanged code and configuration returns the latest existing version.
+	if version == previousVersion {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Alias %s of Lambda function %s already points to version %s, no traffic to shift", aliasName, functionName, version),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Published version %s of Lambda function %s, shifting traffic on alias %s from version %s...", version, functionName, aliasName, previousVersion),
+	})
+
+	for _, percentage := range percentages {
+		weights := map[string]float64{
+			version: float64(percentage) / 100,
+		}
+
+		if err := updateAliasRouting(ctx, conn, functionName, aliasName, previousVersion, weights); err != nil {
+			a.rollback(ctx, conn, resp, functionName, aliasName, previousVersion, fmt.Sprintf("Could not route %d%% of traffic on alias %s to version %s: %s", percentage, aliasName, version, err))
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Routing %d%% of traffic on alias %s to version %s, holding for %s...", percentage, aliasName, version, stepInterval),
+		})
+
+		firing, err := waitAlarmsHealthy(ctx, cloudwatchConn, alarmNames, allowInsufficientData, stepInterval)
+		if err != nil {
+			a.rollback(ctx, conn, resp, functionName, aliasName, previousVersion, fmt.Sprintf("Error while monitoring CloudWatch alarms at %d%% of traffic: %s", percentage, err))
+			return
+		}
+
+		if len(firing) > 0 {
+			a.rollback(ctx, conn, resp, functionName, aliasName, previousVersion, fmt.Sprintf("Alarms left the OK state at %d%% of traffic to version %s: %s", percentage, version, strings.Join(firing, ", ")))
+			return
+		}
+	}
+
+	if err := updateAliasRouting(ctx, conn, functionName, aliasName, version, nil); err != nil {
+		a.rollback(ctx, conn, resp, functionName, aliasName, previousVersion, fmt.Sprintf("Could not route all traffic on alias %s to version %s: %s", aliasName, version, err))
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Alias %s of Lambda function %s now routes all traffic to version %s", aliasName, functionName, version),
+	})
+
+	tflog.Info(ctx, "Lambda publish version and shift alias action completed successfully", map[string]any{
+		"function_name":    functionName,
+		"alias_name":       aliasName,
+		"previous_version": previousVersion,
+		names.AttrVersion:  version,
+	})
+}
+
+// rollback routes all of the alias's traffic back to the previous version and reports the failure that caused it.
+func (a *publishVersionAndShiftAliasAction) rollback(ctx context.Context, conn *lambda.Client, resp *action.InvokeResponse, functionName, aliasName, previousVersion, reason string) {
+	tflog.Warn(ctx, "Rolling back Lambda alias", map[string]any{
+		"function_name":    functionName,
+		"alias_name":       aliasName,
+		"previous_version": previousVersion,
+		"reason":           reason,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rolling back alias %s to version %s...", aliasName, previousVersion),
+	})
+
+	// Roll back even if the deployment timed out.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lambdaPropagationTimeout)
+	defer cancel()
+
+	if err := updateAliasRouting(ctx, conn, functionName, aliasName, previousVersion, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Roll Back Lambda Alias",
+			fmt.Sprintf("%s\n\nCould not roll back alias %s of Lambda function %s to version %s: %s", reason, aliasName, functionName, previousVersion, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Lambda Alias Rolled Back",
+		fmt.Sprintf("%s\n\nAlias %s of Lambda function %s was rolled back to version %s.", reason, aliasName, functionName, previousVersion),
+	)
+}
+
+// updateAliasRouting points the alias at the primary version and routes the given weights to additional versions.
+// A nil or empty weights map removes any weighted routing.
+func updateAliasRouting(ctx context.Context, conn *lambda.Client, functionName, aliasName, primaryVersion string, weights map[string]float64) error {
+	if weights == nil {
+		weights = map[string]float64{}
+	}
+
+	input := lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		FunctionVersion: aws.String(primaryVersion),
+		Name:            aws.String(aliasName),
+		RoutingConfig: &awstypes.AliasRoutingConfiguration{
+			AdditionalVersionWeights: weights,
+		},
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*lambda.UpdateAliasOutput, *awstypes.ResourceConflictException](ctx, lambdaPropagationTimeout, func(ctx context.Context) (*lambda.UpdateAliasOutput, error) {
+		return conn.UpdateAlias(ctx, &input)
+	}, "in progress")
+
+	return err
+}
+
+// waitAlarmsHealthy polls the alarms for the given duration and returns the names of any alarms that leave the OK state.
+// With no alarms it waits for the duration.
+func waitAlarmsHealthy(ctx context.Context, conn *cloudwatch.Client, alarmNames []string, allowInsufficientData bool, duration time.Duration) ([]string, error) {
+	deadline := time.Now().Add(duration)
+
+	for {
+		if len(alarmNames) > 0 {
+			metricAlarms, compositeAlarms, err := findAlarmsByNames(ctx, conn, alarmNames)
+			if err != nil {
+				return nil, err
+			}
+
+			if firing := unhealthyAlarms(metricAlarms, compositeAlarms, allowInsufficientData); len(firing) > 0 {
+				return firing, nil
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(min(remaining, shiftAliasAlarmPollInterval)):
+		}
+	}
+}
+
+func findAlarmsByNames(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]cloudwatchtypes.MetricAlarm, []cloudwatchtypes.CompositeAlarm, error) {
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: enum.EnumValues[cloudwatchtypes.AlarmType](),
+	}
+	var metricAlarms []cloudwatchtypes.MetricAlarm
+	var compositeAlarms []cloudwatchtypes.CompositeAlarm
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		metricAlarms = append(metricAlarms, page.MetricAlarms...)
+		compositeAlarms = append(compositeAlarms, page.CompositeAlarms...)
+	}
+
+	for _, name := range alarmNames {
+		if !slices.ContainsFunc(metricAlarms, func(v cloudwatchtypes.MetricAlarm) bool { return aws.ToString(v.AlarmName) == name }) &&
+			!slices.ContainsFunc(compositeAlarms, func(v cloudwatchtypes.CompositeAlarm) bool { return aws.ToString(v.AlarmName) == name }) {
+			return nil, nil, fmt.Errorf("CloudWatch alarm %s not found", name)
+		}
+	}
+
+	return metricAlarms, compositeAlarms, nil
+}
+
+// unhealthyAlarms returns the sorted names of the alarms that are not in the OK state.
+// Alarms in the INSUFFICIENT_DATA state are healthy if allowInsufficientData is set.
+func unhealthyAlarms(metricAlarms []cloudwatchtypes.MetricAlarm, compositeAlarms []cloudwatchtypes.CompositeAlarm, allowInsufficientData bool) []string {
+	var firing []string
+
+	healthy := func(state cloudwatchtypes.StateValue) bool {
+		return state == cloudwatchtypes.StateValueOk || (allowInsufficientData && state == cloudwatchtypes.StateValueInsufficientData)
+	}
+
+	for _, alarm := range metricAlarms {
+		if !healthy(alarm.StateValue) {
+			firing = append(firing, aws.ToString(alarm.AlarmName))
+		}
+	}
+
+	for _, alarm := range compositeAlarms {
+		if !healthy(alarm.StateValue) {
+			firing = append(firing, aws.ToString(alarm.AlarmName))
+		}
+	}
+
+	slices.Sort(firing)
+
+	return firing
+}
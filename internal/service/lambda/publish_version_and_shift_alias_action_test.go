@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUnhealthyAlarms(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		metricAlarms          []cloudwatchtypes.MetricAlarm
+		compositeAlarms       []cloudwatchtypes.CompositeAlarm
+		allowInsufficientData bool
+		want                  []string
+	}{
+		"no alarms": {},
+		"all ok": {
+			metricAlarms: []cloudwatchtypes.MetricAlarm{
+				{AlarmName: aws.String("errors"), StateValue: cloudwatchtypes.StateValueOk},
+			},
+			compositeAlarms: []cloudwatchtypes.CompositeAlarm{
+				{AlarmName: aws.String("health"), StateValue: cloudwatchtypes.StateValueOk},
+			},
+		},
+		"insufficient data": {
+			metricAlarms: []cloudwatchtypes.MetricAlarm{
+				{AlarmName: aws.String("errors"), StateValue: cloudwatchtypes.StateValueOk},
+				{AlarmName: aws.String("latency"), StateValue: cloudwatchtypes.StateValueInsufficientData},
+			},
+			want: []string{"latency"},
+		},
+		"insufficient data allowed": {
+			metricAlarms: []cloudwatchtypes.MetricAlarm{
+				{AlarmName: aws.String("errors"), StateValue: cloudwatchtypes.StateValueOk},
+				{AlarmName: aws.String("latency"), StateValue: cloudwatchtypes.StateValueInsufficientData},
+			},
+			compositeAlarms: []cloudwatchtypes.CompositeAlarm{
+				{AlarmName: aws.String("health"), StateValue: cloudwatchtypes.StateValueInsufficientData},
+			},
+			allowInsufficientData: true,
+		},
+		"metric firing": {
+			metricAlarms: []cloudwatchtypes.MetricAlarm{
+				{AlarmName: aws.String("latency"), StateValue: cloudwatchtypes.StateValueAlarm},
+				{AlarmName: aws.String("errors"), StateValue: cloudwatchtypes.StateValueOk},
+			},
+			allowInsufficientData: true,
+			want:                  []string{"latency"},
+		},
+		"metric and composite firing": {
+			metricAlarms: []cloudwatchtypes.MetricAlarm{
+				{AlarmName: aws.String("latency"), StateValue: cloudwatchtypes.StateValueAlarm},
+				{AlarmName: aws.String("errors"), StateValue: cloudwatchtypes.StateValueAlarm},
+			},
+			compositeAlarms: []cloudwatchtypes.CompositeAlarm{
+				{AlarmName: aws.String("health"), StateValue: cloudwatchtypes.StateValueAlarm},
+			},
+			want: []string{"errors", "health", "latency"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tflambda.UnhealthyAlarms(testCase.metricAlarms, testCase.compositeAlarms, testCase.allowInsufficientData), testCase.want; !slices.Equal(got, want) {
+				t.Errorf("UnhealthyAlarms() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAccLambdaPublishVersionAndShiftAliasAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckFunctionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublishVersionAndShiftAliasActionConfig_basic(rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublishVersionAndShiftAliasAction(ctx, t, rName, "1"),
+				),
+			},
+			{
+				Config: testAccPublishVersionAndShiftAliasActionConfig_basic(rName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublishVersionAndShiftAliasAction(ctx, t, rName, "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaPublishVersionAndShiftAliasAction_alarmNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckFunctionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPublishVersionAndShiftAliasActionConfig_alarmNotFound(rName),
+				ExpectError: regexache.MustCompile(`CloudWatch alarm .* not found`),
+			},
+		},
+	})
+}
+
+// testAccCheckPublishVersionAndShiftAliasAction checks that the alias routes all of its traffic to the expected version.
+// The alias's state isn't refreshed after the action runs, so the alias is read from the API.
+func testAccCheckPublishVersionAndShiftAliasAction(ctx context.Context, t *testing.T, rName, expectedVersion string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).LambdaClient(ctx)
+
+		output, err := tflambda.FindAliasByTwoPartKey(ctx, conn, rName, rName)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.FunctionVersion), expectedVersion; got != want {
+			return fmt.Errorf("Lambda Alias (%s) function version is %q, want %q", rName, got, want)
+		}
+
+		if output.RoutingConfig != nil && len(output.RoutingConfig.AdditionalVersionWeights) > 0 {
+			return fmt.Errorf("Lambda Alias (%s) has additional version weights %v, want none", rName, output.RoutingConfig.AdditionalVersionWeights)
+		}
+
+		return nil
+	}
+}
+
+func testAccPublishVersionAndShiftAliasActionConfig_function(rName, testData string) string {
+	return acctest.ConfigCompose(
+		testAccInvokeActionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs18.x"
+  publish       = true
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  # The action moves the alias to newly published versions.
+  lifecycle {
+    ignore_changes = [function_version, routing_config]
+  }
+}
+`, rName, testData))
+}
+
+func testAccPublishVersionAndShiftAliasActionConfig_basic(rName, testData string) string {
+	return acctest.ConfigCompose(
+		testAccPublishVersionAndShiftAliasActionConfig_function(rName, testData),
+		`
+action "aws_lambda_publish_version_and_shift_alias" "test" {
+  config {
+    function_name       = aws_lambda_function.test.function_name
+    alias_name          = aws_lambda_alias.test.name
+    traffic_percentages = [10, 50]
+    step_interval       = 0
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_lambda_publish_version_and_shift_alias.test]
+    }
+  }
+}
+`)
+}
+
+func testAccPublishVersionAndShiftAliasActionConfig_alarmNotFound(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPublishVersionAndShiftAliasActionConfig_function(rName, "v1"),
+		fmt.Sprintf(`
+action "aws_lambda_publish_version_and_shift_alias" "test" {
+  config {
+    function_name = aws_lambda_function.test.function_name
+    alias_name    = aws_lambda_alias.test.name
+    alarm_names   = [%[1]q]
+    step_interval = 0
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_publish_version_and_shift_alias.test]
+    }
+  }
+}
+`, rName))
+}
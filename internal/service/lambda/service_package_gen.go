@@ -30,6 +30,12 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 			Name:     "Invoke",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newPublishVersionAndShiftAliasAction,
+			TypeName: "aws_lambda_publish_version_and_shift_alias",
+			Name:     "Publish Version and Shift Alias",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
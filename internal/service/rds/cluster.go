@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -45,7 +46,13 @@ const (
 
 // @SDKResource("aws_rds_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_identifier")
+// @CustomImport
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBCluster")
+// @Testing(importIgnore="apply_immediately;master_password;skip_final_snapshot")
+// @Testing(preIdentityVersion="v6.33.0")
+// @Testing(idAttrDuplicates="cluster_identifier")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -1438,7 +1445,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
-	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.RDSClient(ctx)
 
 	dbc, err := findDBClusterByID(ctx, conn, d.Id())
 
@@ -1452,128 +1460,10 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrAllocatedStorage, dbc.AllocatedStorage)
-	clusterARN := aws.ToString(dbc.DBClusterArn)
-	d.Set(names.AttrARN, clusterARN)
-	d.Set(names.AttrAvailabilityZones, dbc.AvailabilityZones)
-	d.Set("backtrack_window", dbc.BacktrackWindow)
-	d.Set("backup_retention_period", dbc.BackupRetentionPeriod)
-	if dbc.CertificateDetails != nil {
-		d.Set("ca_certificate_identifier", dbc.CertificateDetails.CAIdentifier)
-		d.Set("ca_certificate_valid_till", dbc.CertificateDetails.ValidTill.Format(time.RFC3339))
-	}
-	d.Set("cluster_scalability_type", dbc.ClusterScalabilityType)
-	d.Set(names.AttrClusterIdentifier, dbc.DBClusterIdentifier)
-	d.Set("cluster_identifier_prefix", create.NamePrefixFromName(aws.ToString(dbc.DBClusterIdentifier)))
-	d.Set("cluster_members", tfslices.ApplyToAll(dbc.DBClusterMembers, func(v types.DBClusterMember) string {
-		return aws.ToString(v.DBInstanceIdentifier)
-	}))
-	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
-	d.Set("copy_tags_to_snapshot", dbc.CopyTagsToSnapshot)
-	d.Set("database_insights_mode", dbc.DatabaseInsightsMode)
-	// Only set the DatabaseName if it is not nil. There is a known API bug where
-	// RDS accepts a DatabaseName but does not return it, causing a perpetual
-	// diff.
-	//	See https://github.com/hashicorp/terraform/issues/4671 for backstory
-	if dbc.DatabaseName != nil { // nosemgrep: ci.helper-schema-ResourceData-Set-extraneous-nil-check
-		d.Set(names.AttrDatabaseName, dbc.DatabaseName)
-	}
-	d.Set("db_cluster_instance_class", dbc.DBClusterInstanceClass)
-	d.Set("db_cluster_parameter_group_name", dbc.DBClusterParameterGroup)
-	d.Set("db_subnet_group_name", dbc.DBSubnetGroup)
-	d.Set("db_system_id", dbc.DBSystemId)
-	d.Set(names.AttrDeletionProtection, dbc.DeletionProtection)
-	if len(dbc.DomainMemberships) > 0 {
-		domainMembership := dbc.DomainMemberships[0]
-		d.Set(names.AttrDomain, domainMembership.Domain)
-		d.Set("domain_iam_role_name", domainMembership.IAMRoleName)
-	} else {
-		d.Set(names.AttrDomain, nil)
-		d.Set("domain_iam_role_name", nil)
-	}
-	d.Set("enabled_cloudwatch_logs_exports", dbc.EnabledCloudwatchLogsExports)
-	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
-	d.Set(names.AttrEndpoint, dbc.Endpoint)
-	d.Set(names.AttrEngine, dbc.Engine)
-	d.Set("engine_lifecycle_support", dbc.EngineLifecycleSupport)
-	d.Set("engine_mode", dbc.EngineMode)
-	clusterSetResourceDataEngineVersionFromCluster(d, dbc)
-	d.Set(names.AttrHostedZoneID, dbc.HostedZoneId)
-	d.Set("iam_database_authentication_enabled", dbc.IAMDatabaseAuthenticationEnabled)
-	d.Set("iam_roles", tfslices.ApplyToAll(dbc.AssociatedRoles, func(v types.DBClusterRole) string {
-		return aws.ToString(v.RoleArn)
-	}))
-	d.Set(names.AttrIOPS, dbc.Iops)
-	d.Set(names.AttrKMSKeyID, dbc.KmsKeyId)
-	// Note: the following attributes are not returned by the API
-	// when conducting a read after a create, so we rely on Terraform's
-	// implicit state passthrough, and they are treated as virtual attributes.
-	// https://hashicorp.github.io/terraform-provider-aws/data-handling-and-conversion/#implicit-state-passthrough
-	// https://hashicorp.github.io/terraform-provider-aws/data-handling-and-conversion/#virtual-attributes
-	//
-	// manage_master_user_password
-	// master_password
-	//
-	// Expose the MasterUserSecret structure as a computed attribute
-	// https://awscli.amazonaws.com/v2/documentation/api/latest/reference/rds/create-db-cluster.html#:~:text=for%20future%20use.-,MasterUserSecret,-%2D%3E%20(structure)
-	if dbc.MasterUserSecret != nil {
-		if err := d.Set("master_user_secret", []any{flattenManagedMasterUserSecret(dbc.MasterUserSecret)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting master_user_secret: %s", err)
-		}
-	} else {
-		d.Set("master_user_secret", nil)
-	}
-	d.Set("master_username", dbc.MasterUsername)
-	d.Set("monitoring_interval", dbc.MonitoringInterval)
-	d.Set("monitoring_role_arn", dbc.MonitoringRoleArn)
-	d.Set("network_type", dbc.NetworkType)
-	d.Set("performance_insights_enabled", dbc.PerformanceInsightsEnabled)
-	d.Set("performance_insights_kms_key_id", dbc.PerformanceInsightsKMSKeyId)
-	d.Set("performance_insights_retention_period", dbc.PerformanceInsightsRetentionPeriod)
-	d.Set(names.AttrPort, dbc.Port)
-	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
-	d.Set(names.AttrPreferredMaintenanceWindow, dbc.PreferredMaintenanceWindow)
-	d.Set("reader_endpoint", dbc.ReaderEndpoint)
-	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
-	if dbc.ScalingConfigurationInfo != nil {
-		if err := d.Set("scaling_configuration", []any{flattenScalingConfigurationInfo(dbc.ScalingConfigurationInfo)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting scaling_configuration: %s", err)
-		}
-	} else {
-		d.Set("scaling_configuration", nil)
-	}
-	if dbc.ServerlessV2ScalingConfiguration != nil {
-		if err := d.Set("serverlessv2_scaling_configuration", []any{flattenServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting serverlessv2_scaling_configuration: %s", err)
-		}
-	} else {
-		d.Set("serverlessv2_scaling_configuration", nil)
-	}
-	d.Set(names.AttrStorageEncrypted, dbc.StorageEncrypted)
-	d.Set(names.AttrStorageType, dbc.StorageType)
-	d.Set("upgrade_rollout_order", dbc.UpgradeRolloutOrder)
-	d.Set(names.AttrVPCSecurityGroupIDs, tfslices.ApplyToAll(dbc.VpcSecurityGroups, func(v types.VpcSecurityGroupMembership) string {
-		return aws.ToString(v.VpcSecurityGroupId)
-	}))
-
-	// Fetch and save Global Cluster if engine mode global
-	d.Set("global_cluster_identifier", "")
-
-	if engineMode := aws.ToString(dbc.EngineMode); engineMode == engineModeGlobal || engineMode == engineModeProvisioned {
-		globalCluster, err := findGlobalClusterByDBClusterARN(ctx, conn, clusterARN)
-
-		if err == nil {
-			d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
-		} else if retry.NotFound(err) || tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Access Denied to API Version: APIGlobalDatabases") { //nolint:revive // Keep comments
-			// Ignore the following API error for regions/partitions that do not support RDS Global Clusters:
-			// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
-		} else {
-			return sdkdiag.AppendErrorf(diags, "reading RDS Global Cluster for RDS Cluster (%s): %s", d.Id(), err)
-		}
+	if err := resourceClusterFlatten(ctx, c, dbc, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
 	}
 
-	setTagsOut(ctx, dbc.TagList)
-
 	return diags
 }
 
@@ -2016,7 +1906,11 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 	return diags
 }
 
-func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
 	// from any API call, so we need to default skip_final_snapshot to true so
 	// that final_snapshot_identifier is not required
@@ -2025,6 +1919,134 @@ func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta any)
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceClusterFlatten(ctx context.Context, client *conns.AWSClient, dbc *types.DBCluster, d *schema.ResourceData) error {
+	conn := client.RDSClient(ctx)
+
+	d.Set(names.AttrAllocatedStorage, dbc.AllocatedStorage)
+	clusterARN := aws.ToString(dbc.DBClusterArn)
+	d.Set(names.AttrARN, clusterARN)
+	d.Set(names.AttrAvailabilityZones, dbc.AvailabilityZones)
+	d.Set("backtrack_window", dbc.BacktrackWindow)
+	d.Set("backup_retention_period", dbc.BackupRetentionPeriod)
+	if dbc.CertificateDetails != nil {
+		d.Set("ca_certificate_identifier", dbc.CertificateDetails.CAIdentifier)
+		d.Set("ca_certificate_valid_till", dbc.CertificateDetails.ValidTill.Format(time.RFC3339))
+	}
+	d.Set("cluster_scalability_type", dbc.ClusterScalabilityType)
+	d.Set(names.AttrClusterIdentifier, dbc.DBClusterIdentifier)
+	d.Set("cluster_identifier_prefix", create.NamePrefixFromName(aws.ToString(dbc.DBClusterIdentifier)))
+	d.Set("cluster_members", tfslices.ApplyToAll(dbc.DBClusterMembers, func(v types.DBClusterMember) string {
+		return aws.ToString(v.DBInstanceIdentifier)
+	}))
+	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
+	d.Set("copy_tags_to_snapshot", dbc.CopyTagsToSnapshot)
+	d.Set("database_insights_mode", dbc.DatabaseInsightsMode)
+	// Only set the DatabaseName if it is not nil. There is a known API bug where
+	// RDS accepts a DatabaseName but does not return it, causing a perpetual
+	// diff.
+	//	See https://github.com/hashicorp/terraform/issues/4671 for backstory
+	if dbc.DatabaseName != nil { // nosemgrep: ci.helper-schema-ResourceData-Set-extraneous-nil-check
+		d.Set(names.AttrDatabaseName, dbc.DatabaseName)
+	}
+	d.Set("db_cluster_instance_class", dbc.DBClusterInstanceClass)
+	d.Set("db_cluster_parameter_group_name", dbc.DBClusterParameterGroup)
+	d.Set("db_subnet_group_name", dbc.DBSubnetGroup)
+	d.Set("db_system_id", dbc.DBSystemId)
+	d.Set(names.AttrDeletionProtection, dbc.DeletionProtection)
+	if len(dbc.DomainMemberships) > 0 {
+		domainMembership := dbc.DomainMemberships[0]
+		d.Set(names.AttrDomain, domainMembership.Domain)
+		d.Set("domain_iam_role_name", domainMembership.IAMRoleName)
+	} else {
+		d.Set(names.AttrDomain, nil)
+		d.Set("domain_iam_role_name", nil)
+	}
+	d.Set("enabled_cloudwatch_logs_exports", dbc.EnabledCloudwatchLogsExports)
+	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
+	d.Set(names.AttrEndpoint, dbc.Endpoint)
+	d.Set(names.AttrEngine, dbc.Engine)
+	d.Set("engine_lifecycle_support", dbc.EngineLifecycleSupport)
+	d.Set("engine_mode", dbc.EngineMode)
+	clusterSetResourceDataEngineVersionFromCluster(d, dbc)
+	d.Set(names.AttrHostedZoneID, dbc.HostedZoneId)
+	d.Set("iam_database_authentication_enabled", dbc.IAMDatabaseAuthenticationEnabled)
+	d.Set("iam_roles", tfslices.ApplyToAll(dbc.AssociatedRoles, func(v types.DBClusterRole) string {
+		return aws.ToString(v.RoleArn)
+	}))
+	d.Set(names.AttrIOPS, dbc.Iops)
+	d.Set(names.AttrKMSKeyID, dbc.KmsKeyId)
+	// Note: the following attributes are not returned by the API
+	// when conducting a read after a create, so we rely on Terraform's
+	// implicit state passthrough, and they are treated as virtual attributes.
+	// https://hashicorp.github.io/terraform-provider-aws/data-handling-and-conversion/#implicit-state-passthrough
+	// https://hashicorp.github.io/terraform-provider-aws/data-handling-and-conversion/#virtual-attributes
+	//
+	// manage_master_user_password
+	// master_password
+	//
+	// Expose the MasterUserSecret structure as a computed attribute
+	// https://awscli.amazonaws.com/v2/documentation/api/latest/reference/rds/create-db-cluster.html#:~:text=for%20future%20use.-,MasterUserSecret,-%2D%3E%20(structure)
+	if dbc.MasterUserSecret != nil {
+		if err := d.Set("master_user_secret", []any{flattenManagedMasterUserSecret(dbc.MasterUserSecret)}); err != nil {
+			return fmt.Errorf("setting master_user_secret: %w", err)
+		}
+	} else {
+		d.Set("master_user_secret", nil)
+	}
+	d.Set("master_username", dbc.MasterUsername)
+	d.Set("monitoring_interval", dbc.MonitoringInterval)
+	d.Set("monitoring_role_arn", dbc.MonitoringRoleArn)
+	d.Set("network_type", dbc.NetworkType)
+	d.Set("performance_insights_enabled", dbc.PerformanceInsightsEnabled)
+	d.Set("performance_insights_kms_key_id", dbc.PerformanceInsightsKMSKeyId)
+	d.Set("performance_insights_retention_period", dbc.PerformanceInsightsRetentionPeriod)
+	d.Set(names.AttrPort, dbc.Port)
+	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
+	d.Set(names.AttrPreferredMaintenanceWindow, dbc.PreferredMaintenanceWindow)
+	d.Set("reader_endpoint", dbc.ReaderEndpoint)
+	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
+	if dbc.ScalingConfigurationInfo != nil {
+		if err := d.Set("scaling_configuration", []any{flattenScalingConfigurationInfo(dbc.ScalingConfigurationInfo)}); err != nil {
+			return fmt.Errorf("setting scaling_configuration: %w", err)
+		}
+	} else {
+		d.Set("scaling_configuration", nil)
+	}
+	if dbc.ServerlessV2ScalingConfiguration != nil {
+		if err := d.Set("serverlessv2_scaling_configuration", []any{flattenServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
+			return fmt.Errorf("setting serverlessv2_scaling_configuration: %w", err)
+		}
+	} else {
+		d.Set("serverlessv2_scaling_configuration", nil)
+	}
+	d.Set(names.AttrStorageEncrypted, dbc.StorageEncrypted)
+	d.Set(names.AttrStorageType, dbc.StorageType)
+	d.Set("upgrade_rollout_order", dbc.UpgradeRolloutOrder)
+	d.Set(names.AttrVPCSecurityGroupIDs, tfslices.ApplyToAll(dbc.VpcSecurityGroups, func(v types.VpcSecurityGroupMembership) string {
+		return aws.ToString(v.VpcSecurityGroupId)
+	}))
+
+	// Fetch and save Global Cluster if engine mode global
+	d.Set("global_cluster_identifier", "")
+
+	if engineMode := aws.ToString(dbc.EngineMode); engineMode == engineModeGlobal || engineMode == engineModeProvisioned {
+		globalCluster, err := findGlobalClusterByDBClusterARN(ctx, conn, clusterARN)
+
+		if err == nil {
+			d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+		} else if retry.NotFound(err) || tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Access Denied to API Version: APIGlobalDatabases") { //nolint:revive // Keep comments
+			// Ignore the following API error for regions/partitions that do not support RDS Global Clusters:
+			// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
+		} else {
+			return fmt.Errorf("reading RDS Global Cluster for RDS Cluster (%s): %w", d.Id(), err)
+		}
+	}
+
+	setTagsOut(ctx, dbc.TagList)
+
+	return nil
+}
+
 func enableHTTPEndpointProvisioned(ctx context.Context, conn *rds.Client, arn string, o, n any) error {
 	if o == nil {
 		return nil
@@ -2211,6 +2233,29 @@ func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, wa
 	return nil, err
 }
 
+func waitDBClusterFailedOver(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			clusterStatusFailingOver,
+			clusterStatusModifying,
+			clusterStatusRebooting,
+		},
+		Target:     []string{clusterStatusAvailable},
+		Refresh:    statusDBCluster(conn, id, false),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_rds_cluster")
+func newClusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterListResource{}
+	l.SetResourceSchema(resourceCluster())
+
+	return &l
+}
+
+type clusterListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *clusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query clusterListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBClustersInput
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for cluster, err := range listDBClusters(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			switch aws.ToString(cluster.Engine) {
+			case "docdb", "neptune":
+				// These engines are managed by their respective services' resources.
+				continue
+			}
+
+			id := aws.ToString(cluster.DBClusterIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceClusterFlatten(ctx, awsClient, &cluster, rd); err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBClusters(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClustersInput) iter.Seq2[types.DBCluster, error] {
+	return func(yield func(types.DBCluster, error) bool) {
+		pages := rds.NewDescribeDBClustersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(types.DBCluster{}, fmt.Errorf("listing RDS Clusters: %w", err))
+				return
+			}
+
+			for _, cluster := range page.DBClusters {
+				if !yield(cluster, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -22,6 +22,7 @@ const (
 	clusterStatusConfiguringIAMDatabaseAuth    = "configuring-iam-database-auth"
 	clusterStatusCreating                      = "creating"
 	clusterStatusDeleting                      = "deleting"
+	clusterStatusFailingOver                   = "failing-over"
 	clusterStatusMigrating                     = "migrating"
 	clusterStatusModifying                     = "modifying"
 	clusterStatusPreparingDataMigration        = "preparing-data-migration"
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_cluster_snapshot, name="Create DB Cluster Snapshot")
+func newCreateDBClusterSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBClusterSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBClusterSnapshotAction)(nil)
+)
+
+type createDBClusterSnapshotAction struct {
+	framework.ActionWithModel[createDBClusterSnapshotActionModel]
+}
+
+type createDBClusterSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier         types.String        `tfsdk:"db_cluster_identifier"`
+	DBClusterSnapshotIdentifier types.String        `tfsdk:"db_cluster_snapshot_identifier"`
+	Tags                        fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout                     types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createDBClusterSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an on-demand snapshot of an RDS DB cluster and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to snapshot",
+				Required:    true,
+			},
+			"db_cluster_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier for the DB cluster snapshot. If not provided, an identifier will be generated from the DB cluster identifier and a unique suffix",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the DB cluster snapshot",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBClusterSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBClusterSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	dbClusterID := config.DBClusterIdentifier.ValueString()
+	dbClusterSnapshotID := config.DBClusterSnapshotIdentifier.ValueString()
+
+	if dbClusterSnapshotID == "" {
+		dbClusterSnapshotID = id.PrefixedUniqueId(dbClusterID + "-")
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB cluster snapshot action", map[string]any{
+		"db_cluster_identifier":          dbClusterID,
+		"db_cluster_snapshot_identifier": dbClusterSnapshotID,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting snapshot creation for RDS DB cluster %s...", dbClusterID),
+	})
+
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(dbClusterID),
+		DBClusterSnapshotIdentifier: aws.String(dbClusterSnapshotID),
+		Tags:                        svcTags(tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, config.Tags))),
+	}
+
+	// A snapshot can't be started while the DB cluster is busy, e.g. with an automated backup.
+	_, err := tfresource.RetryWhenIsA[*rds.CreateDBClusterSnapshotOutput, *awstypes.InvalidDBClusterStateFault](ctx, timeout, func(ctx context.Context) (*rds.CreateDBClusterSnapshotOutput, error) {
+		return conn.CreateDBClusterSnapshot(ctx, &input)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Cluster Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB cluster %s: %s", dbClusterSnapshotID, dbClusterID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s started, waiting for completion...", dbClusterSnapshotID),
+	})
+
+	snapshot, err := waitDBClusterSnapshotCreated(ctx, conn, dbClusterSnapshotID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for DB Cluster Snapshot",
+			fmt.Sprintf("Error while waiting for snapshot %s of RDS DB cluster %s to become available: %s", dbClusterSnapshotID, dbClusterID, err),
+		)
+		return
+	}
+
+	snapshotInfo := fmt.Sprintf("Snapshot completed successfully\n"+
+		"  ARN: %s\n"+
+		"  Created: %s",
+		aws.ToString(snapshot.DBClusterSnapshotArn),
+		aws.ToTime(snapshot.SnapshotCreateTime).Format(time.RFC3339),
+	)
+	resp.SendProgress(action.InvokeProgressEvent{Message: snapshotInfo})
+
+	tflog.Info(ctx, "RDS create DB cluster snapshot action completed successfully", map[string]any{
+		"db_cluster_identifier":   dbClusterID,
+		"db_cluster_snapshot_arn": aws.ToString(snapshot.DBClusterSnapshotArn),
+	})
+}
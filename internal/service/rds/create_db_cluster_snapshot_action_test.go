@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBClusterSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	snapshotID := rName + "-snapshot"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBClusterSnapshotActionConfig_basic(rName, snapshotID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBClusterSnapshotActionSnapshot(ctx, t, snapshotID, map[string]string{
+						acctest.CtKey1: acctest.CtValue1,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBClusterSnapshotAction_nonExistentCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBClusterSnapshotActionConfig_nonExistentCluster(rName),
+				ExpectError: regexache.MustCompile(`DBClusterNotFoundFault`),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateDBClusterSnapshotActionSnapshot checks that the cluster snapshot created by the action
+// is available and tagged, then deletes it as it isn't managed by Terraform.
+func testAccCheckCreateDBClusterSnapshotActionSnapshot(ctx context.Context, t *testing.T, id string, tags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, id)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Cluster Snapshot (%s) status is %q, want %q", id, got, want)
+		}
+
+		if err := testAccCheckActionSnapshotTags(output.TagList, tags); err != nil {
+			return fmt.Errorf("RDS DB Cluster Snapshot (%s): %w", id, err)
+		}
+
+		input := rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(id),
+		}
+		_, err = conn.DeleteDBClusterSnapshot(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_basic(rName, snapshotID string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_basic(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = aws_rds_cluster.test.id
+    db_cluster_snapshot_identifier = %[1]q
+
+    tags = {
+      %[2]q = %[3]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_cluster_snapshot.test]
+    }
+  }
+}
+`, snapshotID, acctest.CtKey1, acctest.CtValue1))
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_nonExistentCluster(rName string) string {
+	return fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_cluster_snapshot.test]
+    }
+  }
+}
+`, rName)
+}
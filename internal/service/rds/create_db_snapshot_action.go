@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotActionModel]
+}
+
+type createDBSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String        `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String        `tfsdk:"db_snapshot_identifier"`
+	Tags                 fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout              types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an on-demand snapshot of an RDS DB instance and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier for the DB snapshot. If not provided, an identifier will be generated from the DB instance identifier and a unique suffix",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the DB snapshot",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	dbInstanceID := config.DBInstanceIdentifier.ValueString()
+	dbSnapshotID := config.DBSnapshotIdentifier.ValueString()
+
+	if dbSnapshotID == "" {
+		dbSnapshotID = id.PrefixedUniqueId(dbInstanceID + "-")
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": dbInstanceID,
+		"db_snapshot_identifier": dbSnapshotID,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting snapshot creation for RDS DB instance %s...", dbInstanceID),
+	})
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+		DBSnapshotIdentifier: aws.String(dbSnapshotID),
+		Tags:                 svcTags(tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, config.Tags))),
+	}
+
+	// A snapshot can't be started while the DB instance is busy, e.g. with an automated backup.
+	_, err := tfresource.RetryWhenIsA[*rds.CreateDBSnapshotOutput, *awstypes.InvalidDBInstanceStateFault](ctx, timeout, func(ctx context.Context) (*rds.CreateDBSnapshotOutput, error) {
+		return conn.CreateDBSnapshot(ctx, &input)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB instance %s: %s", dbSnapshotID, dbInstanceID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s started, waiting for completion...", dbSnapshotID),
+	})
+
+	snapshot, err := waitDBSnapshotCreated(ctx, conn, dbSnapshotID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for DB Snapshot",
+			fmt.Sprintf("Error while waiting for snapshot %s of RDS DB instance %s to become available: %s", dbSnapshotID, dbInstanceID, err),
+		)
+		return
+	}
+
+	snapshotInfo := fmt.Sprintf("Snapshot completed successfully\n"+
+		"  ARN: %s\n"+
+		"  Created: %s\n"+
+		"  Allocated storage: %d GiB",
+		aws.ToString(snapshot.DBSnapshotArn),
+		aws.ToTime(snapshot.SnapshotCreateTime).Format(time.RFC3339),
+		aws.ToInt32(snapshot.AllocatedStorage),
+	)
+	resp.SendProgress(action.InvokeProgressEvent{Message: snapshotInfo})
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_instance_identifier": dbInstanceID,
+		"db_snapshot_arn":        aws.ToString(snapshot.DBSnapshotArn),
+	})
+}
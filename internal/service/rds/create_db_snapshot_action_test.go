@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	snapshotID := rName + "-snapshot"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName, snapshotID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSnapshot(ctx, t, snapshotID, map[string]string{
+						acctest.CtKey1: acctest.CtValue1,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBSnapshotAction_nonExistentInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBSnapshotActionConfig_nonExistentInstance(rName),
+				ExpectError: regexache.MustCompile(`DBInstanceNotFound`),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateDBSnapshotActionSnapshot checks that the snapshot created by the action
+// is available and tagged, then deletes it as it isn't managed by Terraform.
+func testAccCheckCreateDBSnapshotActionSnapshot(ctx context.Context, t *testing.T, id string, tags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Snapshot (%s) status is %q, want %q", id, got, want)
+		}
+
+		if err := testAccCheckActionSnapshotTags(output.TagList, tags); err != nil {
+			return fmt.Errorf("RDS DB Snapshot (%s): %w", id, err)
+		}
+
+		input := rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(id),
+		}
+		_, err = conn.DeleteDBSnapshot(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCheckActionSnapshotTags(tagList []types.Tag, want map[string]string) error {
+	got := make(map[string]string, len(tagList))
+	for _, tag := range tagList {
+		got[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	for k, v := range want {
+		if got[k] != v {
+			return fmt.Errorf("tag %q is %q, want %q", k, got[k], v)
+		}
+	}
+
+	return nil
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName, snapshotID string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_basic(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+
+    tags = {
+      %[2]q = %[3]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, snapshotID, acctest.CtKey1, acctest.CtValue1))
+}
+
+func testAccCreateDBSnapshotActionConfig_nonExistentInstance(rName string) string {
+	return fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, rName)
+}
@@ -35,7 +35,9 @@ var (
 	ResourceSnapshotCopy                        = resourceSnapshotCopy
 	ResourceSubnetGroup                         = resourceSubnetGroup
 
+	BuildIAMAuthToken                          = buildIAMAuthToken
 	ClusterIDAndRegionFromARN                  = clusterIDAndRegionFromARN
+	DBClusterWriterInstanceID                  = dbClusterWriterInstanceID
 	FindCustomDBEngineVersionByTwoPartKey      = findCustomDBEngineVersionByTwoPartKey
 	FindDBClusterByID                          = findDBClusterByID
 	FindDBClusterEndpointByID                  = findDBClusterEndpointByID
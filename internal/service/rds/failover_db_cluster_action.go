@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterActionModel]
+}
+
+type failoverDBClusterActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an Aurora DB cluster, promoting a reader to be the writer, and waits for the cluster to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the reader DB instance to promote to the writer. If not provided, Aurora chooses the reader",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the failover to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	id := config.DBClusterIdentifier.ValueString()
+	target := config.TargetDBInstanceIdentifier.ValueString()
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         id,
+		"target_db_instance_identifier": target,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Failing over RDS DB cluster %s...", id),
+	})
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier:        aws.String(id),
+		TargetDBInstanceIdentifier: fwflex.StringFromFramework(ctx, config.TargetDBInstanceIdentifier),
+	}
+
+	output, err := conn.FailoverDBCluster(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Fail Over DB Cluster",
+			fmt.Sprintf("Could not fail over RDS DB cluster %s: %s", id, err),
+		)
+		return
+	}
+
+	previousWriter := dbClusterWriterInstanceID(output.DBCluster)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s is failing over from writer %s, waiting for it to become available...", id, previousWriter),
+	})
+
+	cluster, err := waitDBClusterFailedOver(ctx, conn, id, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for DB Cluster",
+			fmt.Sprintf("Error while waiting for RDS DB cluster %s to become available after failover: %s", id, err),
+		)
+		return
+	}
+
+	writer := dbClusterWriterInstanceID(cluster)
+
+	// Cluster membership can be reported before the promoted reader is shown as the writer.
+	if target != "" && writer != target {
+		writer, err = tfresource.RetryUntilEqual(ctx, timeout, target, func(ctx context.Context) (string, error) {
+			cluster, err := findDBClusterByID(ctx, conn, id)
+			if err != nil {
+				return "", err
+			}
+			return dbClusterWriterInstanceID(cluster), nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Writer",
+				fmt.Sprintf("Error while waiting for DB instance %s to become the writer of RDS DB cluster %s: %s", target, id, err),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s failed over successfully, writer is now %s", id, writer),
+	})
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": id,
+		"previous_writer":       previousWriter,
+		"writer":                writer,
+	})
+}
+
+// dbClusterWriterInstanceID returns the identifier of the writer DB instance of a cluster.
+func dbClusterWriterInstanceID(cluster *awstypes.DBCluster) string {
+	if cluster == nil {
+		return ""
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		if aws.ToBool(member.IsClusterWriter) {
+			return aws.ToString(member.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDBClusterWriterInstanceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cluster *types.DBCluster
+		want    string
+	}{
+		"nil cluster": {},
+		"no members": {
+			cluster: &types.DBCluster{},
+		},
+		"no writer": {
+			cluster: &types.DBCluster{
+				DBClusterMembers: []types.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("reader-1"), IsClusterWriter: aws.Bool(false)},
+				},
+			},
+		},
+		"writer": {
+			cluster: &types.DBCluster{
+				DBClusterMembers: []types.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("reader-1"), IsClusterWriter: aws.Bool(false)},
+					{DBInstanceIdentifier: aws.String("writer-1"), IsClusterWriter: aws.Bool(true)},
+				},
+			},
+			want: "writer-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfrds.DBClusterWriterInstanceID(testCase.cluster), testCase.want; got != want {
+				t.Errorf("DBClusterWriterInstanceID() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBCluster
+	resourceName := "aws_rds_cluster.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, t, resourceName, &v),
+					testAccCheckClusterWriter(ctx, t, resourceName, "aws_rds_cluster_instance.reader"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckClusterWriter checks that the given cluster instance is the writer of the cluster.
+func testAccCheckClusterWriter(ctx context.Context, t *testing.T, nCluster, nInstance string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rsCluster, ok := s.RootModule().Resources[nCluster]
+		if !ok {
+			return fmt.Errorf("Not found: %s", nCluster)
+		}
+
+		rsInstance, ok := s.RootModule().Resources[nInstance]
+		if !ok {
+			return fmt.Errorf("Not found: %s", nInstance)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterByID(ctx, conn, rsCluster.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := tfrds.DBClusterWriterInstanceID(output), rsInstance.Primary.ID; got != want {
+			return fmt.Errorf("RDS Cluster (%s) writer is %q, want %q", rsCluster.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterInstanceConfig_orderableEngineBase(tfrds.ClusterEngineAuroraMySQL, false),
+		fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  database_name       = "test"
+  engine              = data.aws_rds_engine_version.default.engine
+  engine_version      = data.aws_rds_engine_version.default.version
+  master_username     = "tfacctest"
+  master_password     = "avoid-plaintext-passwords"
+  skip_final_snapshot = true
+}
+
+resource "aws_rds_cluster_instance" "writer" {
+  identifier         = "%[1]s-writer"
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+# The reader is created after the writer so that the writer is known before failover.
+resource "aws_rds_cluster_instance" "reader" {
+  identifier         = "%[1]s-reader"
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  depends_on = [aws_rds_cluster_instance.writer]
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier         = aws_rds_cluster.test.id
+    target_db_instance_identifier = aws_rds_cluster_instance.reader.identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.reader]
+}
+`, rName))
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral Resource IAM Auth Token"
+)
+
+const (
+	// iamAuthTokenExpiresIn is the lifetime of RDS IAM authentication tokens.
+	iamAuthTokenExpiresIn = 15 * time.Minute
+	// iamAuthTokenSigningName is the SigV4 signing name for RDS IAM authentication.
+	iamAuthTokenSigningName = "rds-db"
+	// emptyPayloadHash is the SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_rds_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+
+	credentials, err := e.Meta().CredentialsProvider(ctx).Retrieve(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	now := time.Now()
+	token, err := buildIAMAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), credentials, now)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(now.Add(iamAuthTokenExpiresIn))
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Port       types.Int64       `tfsdk:"port"`
+	Token      types.String      `tfsdk:"token"`
+	Username   types.String      `tfsdk:"username"`
+}
+
+// buildIAMAuthToken returns an authentication token for connecting to an RDS database with IAM credentials.
+// The token is a SigV4-presigned "connect" request, built locally without calling any AWS API.
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+func buildIAMAuthToken(ctx context.Context, endpoint, region, username string, credentials aws.Credentials, signingTime time.Time) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	query := request.URL.Query()
+	query.Set("Action", "connect")
+	query.Set("DBUser", username)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(iamAuthTokenExpiresIn.Seconds())))
+	request.URL.RawQuery = query.Encode()
+
+	uri, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, iamAuthTokenSigningName, region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("presigning request: %w", err)
+	}
+
+	return strings.TrimPrefix(uri, "https://"), nil
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_db_instance")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := instanceListResource{}
+	l.SetResourceSchema(resourceInstance())
+
+	return &l
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type instanceListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query instanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBInstancesInput
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for instance, err := range listDBInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			switch aws.ToString(instance.Engine) {
+			case "docdb", "neptune":
+				// These engines are managed by their respective services' resources.
+				continue
+			}
+
+			// Cluster members are managed by aws_rds_cluster_instance.
+			if aws.ToString(instance.DBClusterIdentifier) != "" {
+				continue
+			}
+
+			id := aws.ToString(instance.DbiResourceId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceInstanceFlatten(ctx, awsClient, &instance, rd); err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(instance.DBInstanceIdentifier)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeDBInstancesInput) iter.Seq2[types.DBInstance, error] {
+	return func(yield func(types.DBInstance, error) bool) {
+		pages := rds.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(types.DBInstance{}, fmt.Errorf("listing RDS DB Instances: %w", err))
+				return
+			}
+
+			for _, instance := range page.DBInstances {
+				if !yield(instance, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -75,9 +75,11 @@ func TestAccRDSDBInstance_tags(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(acctest.CtValue1),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -127,9 +129,11 @@ func TestAccRDSDBInstance_tags(t *testing.T) {
 						acctest.CtKey2: config.StringVariable(acctest.CtValue2),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -173,9 +177,11 @@ func TestAccRDSDBInstance_tags(t *testing.T) {
 						acctest.CtKey2: config.StringVariable(acctest.CtValue2),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -207,9 +213,11 @@ func TestAccRDSDBInstance_tags(t *testing.T) {
 					acctest.CtRName:        config.StringVariable(rName),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -266,9 +274,11 @@ func TestAccRDSDBInstance_Tags_null(t *testing.T) {
 						acctest.CtKey1: nil,
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -336,9 +346,11 @@ func TestAccRDSDBInstance_Tags_emptyMap(t *testing.T) {
 					acctest.CtRName:        config.StringVariable(rName),
 					acctest.CtResourceTags: config.MapVariable(map[string]config.Variable{}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -439,9 +451,11 @@ func TestAccRDSDBInstance_Tags_addOnUpdate(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(acctest.CtValue1),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -504,9 +518,11 @@ func TestAccRDSDBInstance_Tags_EmptyTag_onCreate(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(""),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -538,9 +554,11 @@ func TestAccRDSDBInstance_Tags_EmptyTag_onCreate(t *testing.T) {
 					acctest.CtRName:        config.StringVariable(rName),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -639,9 +657,11 @@ func TestAccRDSDBInstance_Tags_EmptyTag_OnUpdate_add(t *testing.T) {
 						acctest.CtKey2: config.StringVariable(""),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -685,9 +705,11 @@ func TestAccRDSDBInstance_Tags_EmptyTag_OnUpdate_add(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(acctest.CtValue1),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -781,9 +803,11 @@ func TestAccRDSDBInstance_Tags_EmptyTag_OnUpdate_replace(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(""),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -846,9 +870,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_providerOnly(t *testing.T) {
 					}),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -896,9 +922,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_providerOnly(t *testing.T) {
 					}),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -942,9 +970,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_providerOnly(t *testing.T) {
 					}),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -978,9 +1008,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_providerOnly(t *testing.T) {
 					acctest.CtRName:        config.StringVariable(rName),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1053,9 +1085,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_nonOverlapping(t *testing.T) {
 						acctest.CtResourceKey1: config.StringVariable(acctest.CtResourceValue1),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1115,9 +1149,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_nonOverlapping(t *testing.T) {
 						acctest.CtResourceKey2: config.StringVariable(acctest.CtResourceValue2),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1151,9 +1187,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_nonOverlapping(t *testing.T) {
 					acctest.CtRName:        config.StringVariable(rName),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1224,9 +1262,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_overlapping(t *testing.T) {
 						acctest.CtOverlapKey1: config.StringVariable(acctest.CtResourceValue1),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1286,9 +1326,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_overlapping(t *testing.T) {
 						acctest.CtOverlapKey2: config.StringVariable(acctest.CtResourceValue2),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1340,9 +1382,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_overlapping(t *testing.T) {
 						acctest.CtOverlapKey1: config.StringVariable(acctest.CtResourceValue2),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1437,9 +1481,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 					}),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1533,9 +1579,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(acctest.CtValue1),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1605,9 +1653,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_emptyResourceTag(t *testing.T) {
 						acctest.CtKey1: config.StringVariable(""),
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1669,9 +1719,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 					}),
 					acctest.CtResourceTags: nil,
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1738,9 +1790,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_nullOverlappingResourceTag(t *testing
 						acctest.CtKey1: nil,
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1807,9 +1861,11 @@ func TestAccRDSDBInstance_Tags_DefaultTags_nullNonOverlappingResourceTag(t *test
 						acctest.CtResourceKey1: nil,
 					}),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1869,9 +1925,11 @@ func TestAccRDSDBInstance_Tags_ComputedTag_onCreate(t *testing.T) {
 					acctest.CtRName: config.StringVariable(rName),
 					"unknownTagKey": config.StringVariable("computedkey1"),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -1973,9 +2031,11 @@ func TestAccRDSDBInstance_Tags_ComputedTag_OnUpdate_add(t *testing.T) {
 					"knownTagKey":   config.StringVariable(acctest.CtKey1),
 					"knownTagValue": config.StringVariable(acctest.CtValue1),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
@@ -2067,9 +2127,11 @@ func TestAccRDSDBInstance_Tags_ComputedTag_OnUpdate_replace(t *testing.T) {
 					acctest.CtRName: config.StringVariable(rName),
 					"unknownTagKey": config.StringVariable(acctest.CtKey1),
 				},
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately, names.AttrPassword,
 				},
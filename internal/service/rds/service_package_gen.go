@@ -7,6 +7,8 @@ package rds
 
 import (
 	"context"
+	"iter"
+	"slices"
 	"unique"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -20,6 +22,45 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newCreateDBClusterSnapshotAction,
+			TypeName: "aws_rds_create_db_cluster_snapshot",
+			Name:     "Create DB Cluster Snapshot",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newCreateDBSnapshotAction,
+			TypeName: "aws_rds_create_db_snapshot",
+			Name:     "Create DB Snapshot",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newFailoverDBClusterAction,
+			TypeName: "aws_rds_failover_db_cluster",
+			Name:     "Failover DB Cluster",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newRebootDBInstanceAction,
+			TypeName: "aws_rds_reboot_db_instance",
+			Name:     "Reboot DB Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newIAMAuthTokenEphemeralResource,
+			TypeName: "aws_rds_iam_auth_token",
+			Name:     "IAM Auth Token",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -209,6 +250,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrIdentifier,
+				inttypes.WithMutableIdentity(),
+			),
+			Import: inttypes.SDKv2Import{
+				CustomImport: true,
+			},
 		},
 		{
 			Factory:  resourceInstanceAutomatedBackupsReplication,
@@ -316,7 +363,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrClusterIdentifier),
+			Import: inttypes.SDKv2Import{
+				CustomImport: true,
+			},
 		},
 		{
 			Factory:  resourceClusterActivityStream,
@@ -387,6 +438,33 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttypes.ServicePackageSDKListResource] {
+	return slices.Values([]*inttypes.ServicePackageSDKListResource{
+		{
+			Factory:  newInstanceResourceAsListResource,
+			TypeName: "aws_db_instance",
+			Name:     "DB Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrIdentifier,
+				inttypes.WithMutableIdentity(),
+			),
+		},
+		{
+			Factory:  newClusterResourceAsListResource,
+			TypeName: "aws_rds_cluster",
+			Name:     "Cluster",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrClusterIdentifier),
+		},
+	})
+}
+
 func (p *servicePackage) ServicePackageName() string {
 	return names.RDS
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -126,9 +127,13 @@ func resourceSchedule() *schema.Resource {
 				)),
 			},
 			names.AttrScheduleExpression: {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringLenBetween(1, 256),
+					// EventBridge Scheduler accepts rate expressions such as rate(1 hours).
+					verify.ValidScheduleExpressionWithOptions([]schedule.ParseOption{schedule.WithAnyRateUnitNumber()}, schedule.KindAt, schedule.KindCron, schedule.KindRate),
+				)),
 			},
 			"schedule_expression_timezone": {
 				Type:             schema.TypeString,
@@ -669,6 +669,36 @@ func TestAccSchedulerSchedule_scheduleExpression(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// EventBridge Scheduler accepts a plural unit for a value of 1.
+				Config: testAccScheduleConfig_scheduleExpression(name, "rate(1 hours)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, names.AttrScheduleExpression, "rate(1 hours)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_invalidScheduleExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_scheduleExpression(name, "rate(2 weeks)"),
+				ExpectError: regexache.MustCompile(`is an invalid schedule expression`),
+			},
 		},
 	})
 }
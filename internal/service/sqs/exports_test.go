@@ -11,6 +11,8 @@ var (
 	ResourceQueueRedrivePolicy      = resourceQueueRedrivePolicy
 
 	FindQueueAttributesByURL = findQueueAttributesByURL
+	MessageMoveTaskByHandle  = messageMoveTaskByHandle
+	MessageMoveTaskCounts    = messageMoveTaskCounts
 
 	DefaultQueueDelaySeconds                  = defaultQueueDelaySeconds
 	DefaultQueueKMSDataKeyReusePeriodSeconds  = defaultQueueKMSDataKeyReusePeriodSeconds
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startMessageMoveTaskPollInterval = 15 * time.Second
+)
+
+// @Action(aws_sqs_start_message_move_task, name="Start Message Move Task")
+func newStartMessageMoveTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startMessageMoveTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*startMessageMoveTaskAction)(nil)
+)
+
+type startMessageMoveTaskAction struct {
+	framework.ActionWithModel[startMessageMoveTaskActionModel]
+}
+
+type startMessageMoveTaskActionModel struct {
+	framework.WithRegionModel
+	DestinationARN               fwtypes.ARN `tfsdk:"destination_arn"`
+	MaxNumberOfMessagesPerSecond types.Int64 `tfsdk:"max_number_of_messages_per_second"`
+	SourceARN                    fwtypes.ARN `tfsdk:"source_arn"`
+	Timeout                      types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *startMessageMoveTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an asynchronous task to move messages from a dead-letter queue back to a source queue and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"destination_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the queue that receives the moved messages. If not provided, messages are moved back to their original source queues",
+				Optional:    true,
+			},
+			"max_number_of_messages_per_second": schema.Int64Attribute{
+				Description: "Maximum number of messages to move per second. If not provided, SQS sets a system-optimized rate",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 500),
+				},
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the dead-letter queue to move messages from",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the message move task to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startMessageMoveTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startMessageMoveTaskActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SQSClient(ctx)
+
+	sourceARN := config.SourceARN.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting SQS message move task action", map[string]any{
+		"source_arn":      sourceARN,
+		"destination_arn": config.DestinationARN.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting message move task for SQS queue %s...", sourceARN),
+	})
+
+	var input sqs.StartMessageMoveTaskInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime := time.Now()
+	output, err := conn.StartMessageMoveTask(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Message Move Task",
+			fmt.Sprintf("Could not start message move task for SQS queue %s: %s", sourceARN, err),
+		)
+		return
+	}
+
+	taskHandle := aws.ToString(output.TaskHandle)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Message move task started for SQS queue %s, waiting for completion...", sourceARN),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.ListMessageMoveTasksResultEntry], error) {
+		task, ferr := findMessageMoveTaskByThreePartKey(ctx, conn, sourceARN, taskHandle, startTime)
+		if retry.NotFound(ferr) {
+			// The task may not be listed yet.
+			return actionwait.FetchResult[*awstypes.ListMessageMoveTasksResultEntry]{Status: messageMoveTaskStatusRunning}, nil
+		}
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.ListMessageMoveTasksResultEntry]{}, fmt.Errorf("listing message move tasks: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.ListMessageMoveTasksResultEntry]{Status: actionwait.Status(aws.ToString(task.Status)), Value: task}, nil
+	}, actionwait.Options[*awstypes.ListMessageMoveTasksResultEntry]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startMessageMoveTaskPollInterval),
+		ProgressInterval: startMessageMoveTaskPollInterval,
+		SuccessStates:    []actionwait.Status{messageMoveTaskStatusCompleted},
+		TransitionalStates: []actionwait.Status{
+			messageMoveTaskStatusRunning,
+			messageMoveTaskStatusCancelling,
+		},
+		FailureStates: []actionwait.Status{
+			messageMoveTaskStatusCancelled,
+			messageMoveTaskStatusFailed,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			task, _ := fr.Value.(*awstypes.ListMessageMoveTasksResultEntry)
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Message move task for SQS queue %s is currently in status '%s', %s, %s remaining before timeout...", sourceARN, fr.Status, messageMoveTaskSummary(task), meta.Remaining.Truncate(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Message Move Task",
+				fmt.Sprintf("Message move task for SQS queue %s did not complete within %s (%s): %s", sourceARN, timeout, messageMoveTaskSummary(fr.Value), err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := "no failure reason given"
+			if fr.Value != nil && aws.ToString(fr.Value.FailureReason) != "" {
+				reason = aws.ToString(fr.Value.FailureReason)
+			}
+			resp.Diagnostics.AddError(
+				"Message Move Task Failed",
+				fmt.Sprintf("Message move task for SQS queue %s ended with status %s (%s): %s", sourceARN, failureErr.Status, messageMoveTaskSummary(fr.Value), reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Message Move Task Status",
+				fmt.Sprintf("Message move task for SQS queue %s entered unexpected status: %s", sourceARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Message Move Task",
+				fmt.Sprintf("Error while waiting for message move task for SQS queue %s: %s", sourceARN, err),
+			)
+		}
+		return
+	}
+
+	moved, failed := messageMoveTaskCounts(fr.Value)
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Message move task for SQS queue %s completed successfully: %d messages moved, %d messages failed to move", sourceARN, moved, failed),
+	})
+
+	tflog.Info(ctx, "SQS message move task action completed successfully", map[string]any{
+		"source_arn":      sourceARN,
+		"messages_moved":  moved,
+		"messages_failed": failed,
+	})
+}
+
+func findMessageMoveTaskByThreePartKey(ctx context.Context, conn *sqs.Client, sourceARN, taskHandle string, startTime time.Time) (*awstypes.ListMessageMoveTasksResultEntry, error) {
+	input := sqs.ListMessageMoveTasksInput{
+		MaxResults: aws.Int32(10),
+		SourceArn:  aws.String(sourceARN),
+	}
+
+	output, err := conn.ListMessageMoveTasks(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	if task := messageMoveTaskByHandle(output.Results, taskHandle, startTime); task != nil {
+		return task, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError()
+}
+
+// messageMoveTaskByHandle returns the message move task with the given handle, started no earlier than startTime.
+// Task handles are only returned while a task is running. Tasks are listed most recent first
+// and only one task can run per source queue, so once the handle is no longer listed the most
+// recent task that isn't running is the one that was started, provided it started after startTime.
+// An earlier task is never returned, as the new task may not be listed yet.
+func messageMoveTaskByHandle(tasks []awstypes.ListMessageMoveTasksResultEntry, taskHandle string, startTime time.Time) *awstypes.ListMessageMoveTasksResultEntry {
+	for i, task := range tasks {
+		if aws.ToString(task.TaskHandle) == taskHandle {
+			return &tasks[i]
+		}
+	}
+
+	startTime = startTime.Truncate(time.Millisecond)
+	for i, task := range tasks {
+		if aws.ToString(task.Status) == messageMoveTaskStatusRunning {
+			continue
+		}
+
+		if time.UnixMilli(task.StartedTimestamp).Before(startTime) {
+			return nil
+		}
+
+		return &tasks[i]
+	}
+
+	return nil
+}
+
+// messageMoveTaskCounts returns the approximate numbers of messages that were moved and that failed to move.
+func messageMoveTaskCounts(task *awstypes.ListMessageMoveTasksResultEntry) (int64, int64) {
+	if task == nil {
+		return 0, 0
+	}
+
+	moved := task.ApproximateNumberOfMessagesMoved
+
+	var failed int64
+	if toMove := task.ApproximateNumberOfMessagesToMove; toMove != nil && aws.ToString(task.Status) != messageMoveTaskStatusRunning {
+		failed = max(aws.ToInt64(toMove)-moved, 0)
+	}
+
+	return moved, failed
+}
+
+// messageMoveTaskSummary describes the progress of a message move task.
+func messageMoveTaskSummary(task *awstypes.ListMessageMoveTasksResultEntry) string {
+	if task == nil {
+		return "no progress reported"
+	}
+
+	if v := task.ApproximateNumberOfMessagesToMove; v != nil {
+		return fmt.Sprintf("%d of %d messages moved", task.ApproximateNumberOfMessagesMoved, aws.ToInt64(v))
+	}
+
+	return fmt.Sprintf("%d messages moved", task.ApproximateNumberOfMessagesMoved)
+}
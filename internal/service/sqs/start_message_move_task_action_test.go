@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestMessageMoveTaskByHandle(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	running := types.ListMessageMoveTasksResultEntry{
+		StartedTimestamp: startTime.Add(time.Minute).UnixMilli(),
+		Status:           aws.String("RUNNING"),
+		TaskHandle:       aws.String("handle-2"),
+	}
+	completed := types.ListMessageMoveTasksResultEntry{
+		StartedTimestamp: startTime.Add(-time.Hour).UnixMilli(),
+		Status:           aws.String("COMPLETED"),
+	}
+	failed := types.ListMessageMoveTasksResultEntry{
+		StartedTimestamp: startTime.UnixMilli(),
+		Status:           aws.String("FAILED"),
+	}
+
+	testCases := map[string]struct {
+		tasks      []types.ListMessageMoveTasksResultEntry
+		taskHandle string
+		want       *types.ListMessageMoveTasksResultEntry
+	}{
+		"no tasks": {
+			taskHandle: "handle-1",
+		},
+		"running": {
+			tasks:      []types.ListMessageMoveTasksResultEntry{running, completed},
+			taskHandle: "handle-2",
+			want:       &running,
+		},
+		"finished": {
+			tasks:      []types.ListMessageMoveTasksResultEntry{failed, completed},
+			taskHandle: "handle-1",
+			want:       &failed,
+		},
+		"only other running": {
+			tasks:      []types.ListMessageMoveTasksResultEntry{running},
+			taskHandle: "handle-1",
+		},
+		"not yet listed": {
+			tasks:      []types.ListMessageMoveTasksResultEntry{completed},
+			taskHandle: "handle-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfsqs.MessageMoveTaskByHandle(testCase.tasks, testCase.taskHandle, startTime)
+
+			if (got == nil) != (testCase.want == nil) {
+				t.Fatalf("MessageMoveTaskByHandle() = %v, want %v", got, testCase.want)
+			}
+			if got != nil && aws.ToString(got.Status) != aws.ToString(testCase.want.Status) {
+				t.Errorf("MessageMoveTaskByHandle() status = %q, want %q", aws.ToString(got.Status), aws.ToString(testCase.want.Status))
+			}
+		})
+	}
+}
+
+func TestMessageMoveTaskCounts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		task       *types.ListMessageMoveTasksResultEntry
+		wantMoved  int64
+		wantFailed int64
+	}{
+		"nil task": {},
+		"completed": {
+			task: &types.ListMessageMoveTasksResultEntry{
+				ApproximateNumberOfMessagesMoved:  10,
+				ApproximateNumberOfMessagesToMove: aws.Int64(10),
+				Status:                            aws.String("COMPLETED"),
+			},
+			wantMoved: 10,
+		},
+		"failed": {
+			task: &types.ListMessageMoveTasksResultEntry{
+				ApproximateNumberOfMessagesMoved:  4,
+				ApproximateNumberOfMessagesToMove: aws.Int64(10),
+				Status:                            aws.String("FAILED"),
+			},
+			wantMoved:  4,
+			wantFailed: 6,
+		},
+		"running": {
+			task: &types.ListMessageMoveTasksResultEntry{
+				ApproximateNumberOfMessagesMoved:  4,
+				ApproximateNumberOfMessagesToMove: aws.Int64(10),
+				Status:                            aws.String("RUNNING"),
+			},
+			wantMoved: 4,
+		},
+		"more moved than counted": {
+			task: &types.ListMessageMoveTasksResultEntry{
+				ApproximateNumberOfMessagesMoved:  12,
+				ApproximateNumberOfMessagesToMove: aws.Int64(10),
+				Status:                            aws.String("COMPLETED"),
+			},
+			wantMoved: 12,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			moved, failed := tfsqs.MessageMoveTaskCounts(testCase.task)
+
+			if moved != testCase.wantMoved || failed != testCase.wantFailed {
+				t.Errorf("MessageMoveTaskCounts() = (%d, %d), want (%d, %d)", moved, failed, testCase.wantMoved, testCase.wantFailed)
+			}
+		})
+	}
+}
+
+func TestAccSQSStartMessageMoveTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckQueueDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartMessageMoveTaskActionConfig_basic(rName, "1"),
+			},
+			{
+				PreConfig: func() {
+					testAccSendMessages(ctx, t, rName+"_dlq", 5)
+				},
+				Config: testAccStartMessageMoveTaskActionConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueApproximateNumberOfMessages(ctx, t, "aws_sqs_queue.test", 5),
+					testAccCheckQueueApproximateNumberOfMessages(ctx, t, "aws_sqs_queue.test_dlq", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSStartMessageMoveTaskAction_nonExistentQueue(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartMessageMoveTaskActionConfig_nonExistentQueue(rName),
+				ExpectError: regexache.MustCompile(`Failed to Start Message Move Task`),
+			},
+		},
+	})
+}
+
+// testAccSendMessages sends messages to the named queue before the action moves them.
+func testAccSendMessages(ctx context.Context, t *testing.T, queueName string, n int) {
+	t.Helper()
+
+	conn := acctest.ProviderMeta(ctx, t).SQSClient(ctx)
+
+	output, err := conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		t.Fatalf("getting SQS Queue (%s) URL: %s", queueName, err)
+	}
+
+	for i := range n {
+		input := sqs.SendMessageInput{
+			MessageBody: aws.String(fmt.Sprintf("message %d", i)),
+			QueueUrl:    output.QueueUrl,
+		}
+		if _, err := conn.SendMessage(ctx, &input); err != nil {
+			t.Fatalf("sending message to SQS Queue (%s): %s", queueName, err)
+		}
+	}
+}
+
+func testAccCheckQueueApproximateNumberOfMessages(ctx context.Context, t *testing.T, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SQSClient(ctx)
+
+		output, err := tfsqs.FindQueueAttributesByURL(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := output[types.QueueAttributeNameApproximateNumberOfMessages]; got != strconv.Itoa(want) {
+			return fmt.Errorf("SQS Queue (%s) approximate number of messages is %s, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartMessageMoveTaskActionConfig_basic(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test_dlq" {
+  name = "%[1]s_dlq"
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "byQueue",
+    sourceQueueArns   = [aws_sqs_queue.test.arn]
+  })
+}
+
+action "aws_sqs_start_message_move_task" "test" {
+  config {
+    source_arn                        = aws_sqs_queue.test_dlq.arn
+    destination_arn                   = aws_sqs_queue.test.arn
+    max_number_of_messages_per_second = 10
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[2]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_sqs_start_message_move_task.test]
+    }
+  }
+
+  depends_on = [aws_sqs_queue.test, aws_sqs_queue.test_dlq]
+}
+`, rName, trigger)
+}
+
+func testAccStartMessageMoveTaskActionConfig_nonExistentQueue(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+action "aws_sqs_start_message_move_task" "test" {
+  config {
+    source_arn = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:%[1]s"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_start_message_move_task.test]
+    }
+  }
+}
+`, rName)
+}
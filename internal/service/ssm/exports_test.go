@@ -18,6 +18,7 @@ var (
 	ResourceResourceDataSync        = resourceResourceDataSync
 	ResourceServiceSetting          = resourceServiceSetting
 
+	CommandInvocationSummary                           = commandInvocationSummary
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -345,6 +346,24 @@ func TestAccSSMMaintenanceWindow_schedule(t *testing.T) {
 	})
 }
 
+func TestAccSSMMaintenanceWindow_invalidSchedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceWindowDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMaintenanceWindowConfig_schedule(rName, "cron(0 16 ? * TUE)"),
+				ExpectError: regexache.MustCompile(`is an invalid schedule expression`),
+			},
+		},
+	})
+}
+
 func TestAccSSMMaintenanceWindow_scheduleTimezone(t *testing.T) {
 	ctx := acctest.Context(t)
 	var maintenanceWindow1, maintenanceWindow2, maintenanceWindow3 ssm.GetMaintenanceWindowOutput
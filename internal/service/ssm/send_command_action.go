@@ -0,0 +1,397 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandPollInterval defines polling cadence for send command action.
+	sendCommandPollInterval = 10 * time.Second
+
+	// commandOutputMaxLength is the number of characters of each target's output included in messages.
+	commandOutputMaxLength = 1000
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandModel]
+}
+
+type sendCommandModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                 `tfsdk:"comment"`
+	DocumentName    types.String                                 `tfsdk:"document_name"`
+	DocumentVersion types.String                                 `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.SetOfString                          `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                 `tfsdk:"max_errors"`
+	Parameters      fwtypes.MapOfString                          `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed nodes with Run Command and waits for the command to finish.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the SSM document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the SSM document to run",
+				Optional:    true,
+			},
+			"instance_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Description: "IDs of the managed nodes on which to run the command. Conflicts with targets",
+				Optional:    true,
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of managed nodes that can run the command at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number or percentage of errors allowed before the command stops being sent to other managed nodes",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": targetsBlock(ctx),
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	if hasInstanceIDs, hasTargets := len(config.InstanceIDs.Elements()) > 0, len(config.Targets.Elements()) > 0; hasInstanceIDs == hasTargets {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("instance_ids"),
+			"Invalid Attribute Combination",
+			"Exactly one of instance_ids or targets must be specified.",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending command to run SSM document %s...", documentName),
+	})
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueSet(ctx, config.InstanceIDs),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+		Parameters:      expandActionParameters(ctx, config.Parameters),
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send command to run SSM document %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for it to finish...", commandID),
+	})
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, ferr := findCommandByID(ctx, conn, commandID)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("listing command: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Command %s is currently in state '%s'", commandID, fr.Status)
+			if v, ok := fr.Value.(*awstypes.Command); ok && v != nil {
+				message += fmt.Sprintf(", %d of %d targets completed (%d errors)", v.CompletedCount, v.TargetCount, v.ErrorCount)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message + ", continuing to wait..."})
+		},
+	})
+
+	// Per-target results are reported whether or not the command succeeded.
+	invocations, ierr := findCommandInvocationsByCommandID(ctx, conn, commandID)
+	if ierr != nil {
+		resp.Diagnostics.AddWarning(
+			"Failed to List Command Invocations",
+			fmt.Sprintf("Could not list invocations of command %s: %s", commandID, ierr),
+		)
+	}
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("Command %s did not finish within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("Command %s finished with status %s.\n\n%s", commandID, failureErr.Status, commandInvocationsSummary(invocations)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command State",
+				fmt.Sprintf("Command %s entered unexpected state: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for command %s to finish: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	for _, invocation := range invocations {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: commandInvocationSummary(invocation),
+		})
+	}
+
+	// Progress events aren't kept after the run, so the per-target results are also returned as a diagnostic.
+	if len(invocations) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Command Results",
+			fmt.Sprintf("Command %s finished successfully.\n\n%s", commandID, commandInvocationsSummary(invocations)),
+		)
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s finished successfully on %d targets", commandID, len(invocations)),
+	})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"document_name": documentName,
+		"command_id":    commandID,
+	})
+}
+
+func targetsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+		Description: "Targets selected by tag or resource group",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(5),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Description: "Target key, for example tag:Environment or resource-groups:Name",
+					Required:    true,
+				},
+				names.AttrValues: schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Description: "Target values",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// expandActionParameters expands a map of single-valued document parameters.
+func expandActionParameters(ctx context.Context, v fwtypes.MapOfString) map[string][]string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return tfmaps.ApplyToAllValues(fwflex.ExpandFrameworkStringValueMap(ctx, v), func(v string) []string {
+		return []string{v}
+	})
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+// commandInvocationsSummary describes the status and output of each target of a command.
+func commandInvocationsSummary(invocations []awstypes.CommandInvocation) string {
+	summaries := make([]string, 0, len(invocations))
+
+	for _, invocation := range invocations {
+		summaries = append(summaries, commandInvocationSummary(invocation))
+	}
+
+	return strings.Join(summaries, "\n\n")
+}
+
+// commandInvocationSummary describes the status and output of a command on a single target.
+func commandInvocationSummary(invocation awstypes.CommandInvocation) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s: %s", aws.ToString(invocation.InstanceId), invocation.Status)
+	if v := aws.ToString(invocation.StatusDetails); v != "" && v != string(invocation.Status) {
+		fmt.Fprintf(&sb, " (%s)", v)
+	}
+
+	for _, plugin := range invocation.CommandPlugins {
+		output := strings.TrimSpace(aws.ToString(plugin.Output))
+		if output == "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n[%s] exit code %d:\n%s", aws.ToString(plugin.Name), plugin.ResponseCode, truncateOutput(output, commandOutputMaxLength))
+	}
+
+	return sb.String()
+}
+
+// truncateOutput returns at most maxLength characters of output, marking any truncation.
+func truncateOutput(output string, maxLength int) string {
+	if utf8.RuneCountInString(output) <= maxLength {
+		return output
+	}
+
+	return string([]rune(output)[:maxLength]) + "\n... (truncated)"
+}
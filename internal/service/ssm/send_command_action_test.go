@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCommandInvocationSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		invocation awstypes.CommandInvocation
+		want       string
+	}{
+		"no output": {
+			invocation: awstypes.CommandInvocation{
+				InstanceId:    aws.String("i-1234567890abcdef0"),
+				Status:        awstypes.CommandInvocationStatusSuccess,
+				StatusDetails: aws.String("Success"),
+			},
+			want: "i-1234567890abcdef0: Success",
+		},
+		"output": {
+			invocation: awstypes.CommandInvocation{
+				InstanceId:    aws.String("i-1234567890abcdef0"),
+				Status:        awstypes.CommandInvocationStatusFailed,
+				StatusDetails: aws.String("Failed"),
+				CommandPlugins: []awstypes.CommandPlugin{
+					{Name: aws.String("aws:runShellScript"), Output: aws.String("failing\n"), ResponseCode: 3},
+				},
+			},
+			want: "i-1234567890abcdef0: Failed\n[aws:runShellScript] exit code 3:\nfailing",
+		},
+		"status details": {
+			invocation: awstypes.CommandInvocation{
+				InstanceId:    aws.String("i-1234567890abcdef0"),
+				Status:        awstypes.CommandInvocationStatusTimedOut,
+				StatusDetails: aws.String("DeliveryTimedOut"),
+			},
+			want: "i-1234567890abcdef0: TimedOut (DeliveryTimedOut)",
+		},
+		"truncated output": {
+			invocation: awstypes.CommandInvocation{
+				InstanceId: aws.String("i-1234567890abcdef0"),
+				Status:     awstypes.CommandInvocationStatusSuccess,
+				CommandPlugins: []awstypes.CommandPlugin{
+					{Name: aws.String("aws:runShellScript"), Output: aws.String(strings.Repeat("x", 1001))},
+				},
+			},
+			want: "i-1234567890abcdef0: Success\n[aws:runShellScript] exit code 0:\n" + strings.Repeat("x", 1000) + "\n... (truncated)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfssm.CommandInvocationSummary(testCase.invocation), testCase.want; got != want {
+				t.Errorf("CommandInvocationSummary() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig: testAccSendCommandActionRegistrationSleep,
+				Config:    testAccSendCommandActionConfig_instanceIDs(rName, "echo hello"),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig: testAccSendCommandActionRegistrationSleep,
+				Config:    testAccSendCommandActionConfig_targets(rName),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig:   testAccSendCommandActionRegistrationSleep,
+				Config:      testAccSendCommandActionConfig_instanceIDs(rName, "echo failing; exit 3"),
+				ExpectError: regexache.MustCompile(`(?s)Command Failed.*failing`),
+			},
+		},
+	})
+}
+
+func testAccSendCommandActionRegistrationSleep() {
+	log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+	time.Sleep(1 * time.Minute)
+}
+
+func testAccSendCommandActionConfig_instanceIDs(rName, command string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    comment       = %[1]q
+    timeout       = 600
+
+    parameters = {
+      commands = %[2]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName, command))
+}
+
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name   = "AWS-RunShellScript"
+    max_concurrency = "50%%"
+    max_errors      = "0"
+
+    targets {
+      key    = "tag:Name"
+      values = [%[1]q]
+    }
+
+    parameters = {
+      commands = "uname -a"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
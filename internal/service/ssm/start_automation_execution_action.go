@@ -0,0 +1,397 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// startAutomationExecutionPollInterval defines polling cadence for start automation execution action.
+	startAutomationExecutionPollInterval = 15 * time.Second
+
+	// automationTargetParameterNameDefault is the document parameter that targets are passed to unless specified.
+	automationTargetParameterNameDefault = "InstanceId"
+	// automationTargetKeyParameterValues is the target key used to pass explicit values to the target parameter.
+	automationTargetKeyParameterValues = "ParameterValues"
+)
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionModel]
+}
+
+type startAutomationExecutionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                 `tfsdk:"document_name"`
+	DocumentVersion     types.String                                 `tfsdk:"document_version"`
+	InstanceIDs         fwtypes.SetOfString                          `tfsdk:"instance_ids"`
+	MaxConcurrency      types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                 `tfsdk:"max_errors"`
+	Parameters          fwtypes.MapOfString                          `tfsdk:"parameters"`
+	TargetParameterName types.String                                 `tfsdk:"target_parameter_name"`
+	Targets             fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook execution and waits for it to finish.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the Automation runbook to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the Automation runbook to run",
+				Optional:    true,
+			},
+			"instance_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Description: "IDs of the managed nodes on which to run the runbook, passed to the target parameter. Conflicts with targets",
+				Optional:    true,
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of targets allowed to run the runbook in parallel",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number or percentage of errors allowed before the runbook stops running on other targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Parameters to pass to the Automation runbook",
+				Optional:    true,
+			},
+			"target_parameter_name": schema.StringAttribute{
+				Description: "Runbook parameter to which target values are passed (default: InstanceId)",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": targetsBlock(ctx),
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	hasInstanceIDs, hasTargets := len(config.InstanceIDs.Elements()) > 0, len(config.Targets.Elements()) > 0
+	if hasInstanceIDs && hasTargets {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("instance_ids"),
+			"Invalid Attribute Combination",
+			"Only one of instance_ids or targets can be specified.",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting execution of Automation runbook %s...", documentName),
+	})
+
+	input := ssm.StartAutomationExecutionInput{
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+		Parameters:      expandActionParameters(ctx, config.Parameters),
+	}
+	if hasInstanceIDs || hasTargets {
+		input.TargetParameterName = aws.String(automationTargetParameterNameDefault)
+		if !config.TargetParameterName.IsNull() {
+			input.TargetParameterName = fwflex.StringFromFramework(ctx, config.TargetParameterName)
+		}
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if hasInstanceIDs {
+		input.Targets = []awstypes.Target{{
+			Key:    aws.String(automationTargetKeyParameterValues),
+			Values: fwflex.ExpandFrameworkStringValueSet(ctx, config.InstanceIDs),
+		}}
+	}
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start execution of Automation runbook %s: %s", documentName, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s started, waiting for it to finish...", executionID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		execution, ferr := findAutomationExecutionByID(ctx, conn, executionID)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("getting automation execution: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: execution}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Automation execution %s is currently in state '%s'", executionID, fr.Status)
+			if v, ok := fr.Value.(*awstypes.AutomationExecution); ok && v != nil {
+				if v.ProgressCounters != nil && v.ProgressCounters.TotalSteps > 0 {
+					message += fmt.Sprintf(", %d of %d targets completed", v.ProgressCounters.SuccessSteps+v.ProgressCounters.FailedSteps+v.ProgressCounters.CancelledSteps+v.ProgressCounters.TimedOutSteps, v.ProgressCounters.TotalSteps)
+				} else if v := aws.ToString(v.CurrentStepName); v != "" {
+					message += fmt.Sprintf(", running step %s", v)
+				}
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message + ", continuing to wait..."})
+		},
+	})
+
+	// Rate-controlled executions run a child execution per target; otherwise results are reported per step.
+	var summaries []string
+	if fr.Value != nil {
+		if len(fr.Value.Targets) > 0 {
+			input := ssm.DescribeAutomationExecutionsInput{
+				Filters: []awstypes.AutomationExecutionFilter{{
+					Key:    awstypes.AutomationExecutionFilterKeyParentExecutionId,
+					Values: []string{executionID},
+				}},
+			}
+			children, ierr := findAutomationExecutions(ctx, conn, &input)
+			if ierr != nil {
+				resp.Diagnostics.AddWarning(
+					"Failed to Describe Automation Executions",
+					fmt.Sprintf("Could not describe child executions of automation execution %s: %s", executionID, ierr),
+				)
+			}
+			for _, child := range children {
+				summaries = append(summaries, automationTargetSummary(child))
+			}
+		} else {
+			for _, step := range fr.Value.StepExecutions {
+				summaries = append(summaries, automationStepSummary(step))
+			}
+		}
+	}
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Automation Execution",
+				fmt.Sprintf("Automation execution %s did not finish within %s: %s", executionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Automation execution %s finished with status %s", executionID, failureErr.Status)
+			if fr.Value != nil && aws.ToString(fr.Value.FailureMessage) != "" {
+				detail += ": " + aws.ToString(fr.Value.FailureMessage)
+			}
+			resp.Diagnostics.AddError(
+				"Automation Execution Failed",
+				fmt.Sprintf("%s.\n\n%s", detail, strings.Join(summaries, "\n\n")),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Automation Execution State",
+				fmt.Sprintf("Automation execution %s entered unexpected state: %s", executionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Automation Execution",
+				fmt.Sprintf("Error while waiting for automation execution %s to finish: %s", executionID, err),
+			)
+		}
+		return
+	}
+
+	for _, summary := range summaries {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: summary,
+		})
+	}
+
+	// Progress events aren't kept after the run, so the per-target or per-step results are also returned as a diagnostic.
+	if len(summaries) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Automation Execution Results",
+			fmt.Sprintf("Automation execution %s finished successfully.\n\n%s", executionID, strings.Join(summaries, "\n\n")),
+		)
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s finished successfully", executionID),
+	})
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"document_name":           documentName,
+		"automation_execution_id": executionID,
+	})
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.AutomationExecution, nil
+}
+
+func findAutomationExecutions(ctx context.Context, conn *ssm.Client, input *ssm.DescribeAutomationExecutionsInput) ([]awstypes.AutomationExecutionMetadata, error) {
+	var output []awstypes.AutomationExecutionMetadata
+
+	pages := ssm.NewDescribeAutomationExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationExecutionMetadataList...)
+	}
+
+	return output, nil
+}
+
+// automationTargetSummary describes the status of a child execution of a rate-controlled automation on a single target.
+func automationTargetSummary(execution awstypes.AutomationExecutionMetadata) string {
+	summary := fmt.Sprintf("%s: %s", aws.ToString(execution.Target), execution.AutomationExecutionStatus)
+	if v := aws.ToString(execution.FailureMessage); v != "" {
+		summary += "\n" + truncateOutput(v, commandOutputMaxLength)
+	}
+
+	return summary
+}
+
+// automationStepSummary describes the status and outputs of a single step of an automation.
+func automationStepSummary(step awstypes.StepExecution) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s (%s): %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus)
+	if v := aws.ToString(step.FailureMessage); v != "" {
+		fmt.Fprintf(&sb, "\n%s", truncateOutput(v, commandOutputMaxLength))
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(step.Outputs)) {
+		fmt.Fprintf(&sb, "\n%s: %s", k, truncateOutput(strings.Join(step.Outputs[k], ", "), commandOutputMaxLength))
+	}
+
+	return sb.String()
+}
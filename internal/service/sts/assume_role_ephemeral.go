@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Resource Assume Role"
+)
+
+const (
+	// credentialsRenewBefore is how long before expiration Terraform is asked to renew temporary credentials.
+	credentialsRenewBefore = 5 * time.Minute
+	// credentialsPrivateKey is the private state key holding the expiration of temporary credentials.
+	credentialsPrivateKey = "credentials"
+	// roleSessionNamePrefix is the prefix of generated role session names.
+	roleSessionNamePrefix = "terraform-"
+)
+
+// @EphemeralResource(aws_sts_assume_role, name="Assume Role")
+func newAssumeRoleEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleEphemeralResourceModel]
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: timetypes.GoDurationType{},
+			Optional:   true,
+		},
+		names.AttrExternalID: schema.StringAttribute{
+			Optional: true,
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfARNType,
+			ElementType: fwtypes.ARNType,
+			Optional:    true,
+		},
+		names.AttrRoleARN: schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		"role_session_name": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"source_identity": schema.StringAttribute{
+			Optional: true,
+		},
+		names.AttrTags: schema.MapAttribute{
+			CustomType:  fwtypes.MapOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"transitive_tag_keys": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"assumed_role_arn": schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_id": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	maps.Copy(attributes, credentialsAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	data := assumeRoleEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleSessionName.IsNull() || data.RoleSessionName.IsUnknown() {
+		data.RoleSessionName = types.StringValue(sdkid.PrefixedUniqueId(roleSessionNamePrefix))
+	}
+
+	input := sts.AssumeRoleInput{
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:        expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		RoleArn:           fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		Tags:              expandTags(ctx, data.Tags),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+
+	durationSeconds, diags := expandDurationSeconds(data.Duration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DurationSeconds = durationSeconds
+
+	output, err := conn.AssumeRole(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRole, data.RoleARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if output.AssumedRoleUser != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	}
+
+	response.Diagnostics.Append(data.credentialsModel.flatten(output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RenewAt, diags = openCredentials(ctx, response.Private, output.Credentials)
+	response.Diagnostics.Append(diags...)
+}
+
+func (e *assumeRoleEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	var diags diag.Diagnostics
+	response.RenewAt, diags = renewCredentials(ctx, request.Private, time.Now())
+	response.Diagnostics.Append(diags...)
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	framework.WithRegionModel
+	credentialsModel
+	AssumedRoleARN    types.String         `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String         `tfsdk:"assumed_role_id"`
+	Duration          timetypes.GoDuration `tfsdk:"duration"`
+	ExternalID        types.String         `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy    `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN     `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN          `tfsdk:"role_arn"`
+	RoleSessionName   types.String         `tfsdk:"role_session_name"`
+	SourceIdentity    types.String         `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString  `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString  `tfsdk:"transitive_tag_keys"`
+}
+
+// credentialsModel holds the temporary credentials returned by the STS AssumeRole* APIs.
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
+
+func (m *credentialsModel) flatten(credentials *awstypes.Credentials) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if credentials == nil {
+		diags.AddError("Missing Credentials", "The AWS STS API returned no credentials.")
+		return diags
+	}
+
+	m.AccessKeyID = types.StringPointerValue(credentials.AccessKeyId)
+	m.Expiration = timetypes.NewRFC3339TimePointerValue(credentials.Expiration)
+	m.SecretAccessKey = types.StringPointerValue(credentials.SecretAccessKey)
+	m.SessionToken = types.StringPointerValue(credentials.SessionToken)
+
+	return diags
+}
+
+func credentialsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_key_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"expiration": schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Computed:   true,
+		},
+		"secret_access_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"session_token": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+// credentialsPrivateData is the private state of an ephemeral resource returning temporary credentials.
+type credentialsPrivateData struct {
+	Expiration time.Time `json:"expiration"`
+}
+
+// openCredentials records the expiration of newly opened temporary credentials in private state
+// and returns the time at which Terraform should renew them.
+func openCredentials(ctx context.Context, private privateData, credentials *awstypes.Credentials) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expiration := aws.ToTime(credentials.Expiration)
+	if expiration.IsZero() {
+		return time.Time{}, diags
+	}
+
+	v, err := json.Marshal(credentialsPrivateData{Expiration: expiration})
+	if err != nil {
+		diags.AddError("Saving Credentials Expiration", err.Error())
+		return time.Time{}, diags
+	}
+
+	diags.Append(private.SetKey(ctx, credentialsPrivateKey, v)...)
+
+	return expiration.Add(-credentialsRenewBefore), diags
+}
+
+// renewCredentials handles the renewal of temporary credentials, returning the time at which Terraform should next renew them.
+// STS credentials can't be extended and Renew can't return new values, so
+// Terraform is warned when the credentials are about to expire and the operation
+// is stopped once they have expired, rather than failing later with authentication errors.
+func renewCredentials(ctx context.Context, private privateData, now time.Time) (time.Time, diag.Diagnostics) {
+	v, diags := private.GetKey(ctx, credentialsPrivateKey)
+	if diags.HasError() || v == nil {
+		return time.Time{}, diags
+	}
+
+	var data credentialsPrivateData
+	if err := json.Unmarshal(v, &data); err != nil {
+		diags.AddError("Reading Credentials Expiration", err.Error())
+		return time.Time{}, diags
+	}
+
+	expiration := data.Expiration.Format(time.RFC3339)
+	if !now.Before(data.Expiration) {
+		diags.AddError(
+			"Temporary Credentials Expired",
+			fmt.Sprintf("The temporary credentials expired at %s. Increase duration so that the credentials outlast the Terraform operation.", expiration),
+		)
+		return time.Time{}, diags
+	}
+
+	diags.AddWarning(
+		"Temporary Credentials Expiring",
+		fmt.Sprintf("The temporary credentials expire at %s and can't be renewed. Operations using them after that time will fail.", expiration),
+	)
+
+	return data.Expiration, diags
+}
+
+// privateData is implemented by ephemeral resource private state.
+type privateData interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+func expandDurationSeconds(v timetypes.GoDuration) (*int32, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	duration, diags := v.ValueGoDuration()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return aws.Int32(int32(duration.Seconds())), diags
+}
+
+func expandPolicyDescriptorTypes(ctx context.Context, v fwtypes.SetOfARN) []awstypes.PolicyDescriptorType {
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, v)
+	if len(arns) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.PolicyDescriptorType, 0, len(arns))
+	for _, arn := range arns {
+		apiObjects = append(apiObjects, awstypes.PolicyDescriptorType{
+			Arn: aws.String(arn),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTags(ctx context.Context, v fwtypes.MapOfString) []awstypes.Tag {
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, v)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.Tag, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestOpenCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	expiration := time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	private := testPrivateData{}
+	renewAt, diags := tfsts.OpenCredentials(ctx, private, &awstypes.Credentials{Expiration: aws.Time(expiration)})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if want := expiration.Add(-5 * time.Minute); !renewAt.Equal(want) {
+		t.Errorf("renew at = %s, want %s", renewAt, want)
+	}
+
+	// Renewal reads back the recorded expiration.
+	renewAt, diags = tfsts.RenewCredentials(ctx, private, expiration.Add(-time.Minute))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !renewAt.Equal(expiration) {
+		t.Errorf("renew at = %s, want %s", renewAt, expiration)
+	}
+
+	private = testPrivateData{}
+	renewAt, diags = tfsts.OpenCredentials(ctx, private, &awstypes.Credentials{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !renewAt.IsZero() {
+		t.Errorf("renew at = %s, want zero for credentials without expiration", renewAt)
+	}
+	if len(private) != 0 {
+		t.Errorf("private state = %v, want empty for credentials without expiration", private)
+	}
+}
+
+func TestRenewCredentials(t *testing.T) {
+	t.Parallel()
+
+	expiration := time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC)
+	private := testPrivateData{
+		"credentials": []byte(`{"expiration":"2025-01-01T01:00:00Z"}`),
+	}
+
+	testCases := map[string]struct {
+		private         testPrivateData
+		now             time.Time
+		expectedRenewAt time.Time
+		expectedSummary string
+		expectedError   bool
+	}{
+		"no private state": {
+			private: testPrivateData{},
+			now:     expiration,
+		},
+		"before expiration": {
+			private:         private,
+			now:             expiration.Add(-4 * time.Minute),
+			expectedRenewAt: expiration,
+			expectedSummary: "Temporary Credentials Expiring",
+		},
+		"at expiration": {
+			private:         private,
+			now:             expiration,
+			expectedSummary: "Temporary Credentials Expired",
+			expectedError:   true,
+		},
+		"after expiration": {
+			private:         private,
+			now:             expiration.Add(time.Minute),
+			expectedSummary: "Temporary Credentials Expired",
+			expectedError:   true,
+		},
+		"invalid private state": {
+			private: testPrivateData{
+				"credentials": []byte(`{`),
+			},
+			now:             expiration,
+			expectedSummary: "Reading Credentials Expiration",
+			expectedError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			renewAt, diags := tfsts.RenewCredentials(t.Context(), testCase.private, testCase.now)
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Fatalf("error = %t, want %t: %v", got, want, diags)
+			}
+			if !renewAt.Equal(testCase.expectedRenewAt) {
+				t.Errorf("renew at = %s, want %s", renewAt, testCase.expectedRenewAt)
+			}
+
+			var summaries []string
+			for _, d := range diags {
+				summaries = append(summaries, d.Summary())
+			}
+			if got, want := strings.Join(summaries, ", "), testCase.expectedSummary; got != want {
+				t.Errorf("diagnostics = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// testPrivateData is an in-memory ephemeral resource private state.
+type testPrivateData map[string][]byte
+
+func (d testPrivateData) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return d[key], nil
+}
+
+func (d testPrivateData) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	d[key] = value
+	return nil
+}
+
+func TestAccSTSAssumeRoleEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_arn"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("role_session_name"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_access_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("session_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_sessionName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralResourceConfig_sessionName(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("role_session_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("session_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccAssumeRoleEphemeralResourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAssumeRoleEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssumeRoleEphemeralResourceConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn = aws_iam_role.test.arn
+}
+`)
+}
+
+func testAccAssumeRoleEphemeralResourceConfig_sessionName(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssumeRoleEphemeralResourceConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn          = aws_iam_role.test.arn
+  role_session_name = %[1]q
+  duration          = "15m"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRoleWithWebIdentity = "Ephemeral Resource Assume Role With Web Identity"
+)
+
+// @EphemeralResource(aws_sts_assume_role_with_web_identity, name="Assume Role With Web Identity")
+func newAssumeRoleWithWebIdentityEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleWithWebIdentityEphemeralResource{}, nil
+}
+
+type assumeRoleWithWebIdentityEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleWithWebIdentityEphemeralResourceModel]
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: timetypes.GoDurationType{},
+			Optional:   true,
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfARNType,
+			ElementType: fwtypes.ARNType,
+			Optional:    true,
+		},
+		"provider_id": schema.StringAttribute{
+			Optional: true,
+		},
+		names.AttrRoleARN: schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		"role_session_name": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"web_identity_token": schema.StringAttribute{
+			Required:  true,
+			Sensitive: true,
+		},
+		"assumed_role_arn": schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"audience": schema.StringAttribute{
+			Computed: true,
+		},
+		"identity_provider": schema.StringAttribute{
+			Computed: true,
+		},
+		"source_identity": schema.StringAttribute{
+			Computed: true,
+		},
+		"subject_from_web_identity_token": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	maps.Copy(attributes, credentialsAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	data := assumeRoleWithWebIdentityEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleSessionName.IsNull() || data.RoleSessionName.IsUnknown() {
+		data.RoleSessionName = types.StringValue(sdkid.PrefixedUniqueId(roleSessionNamePrefix))
+	}
+
+	input := sts.AssumeRoleWithWebIdentityInput{
+		Policy:           fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:       expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		ProviderId:       fwflex.StringFromFramework(ctx, data.ProviderID),
+		RoleArn:          fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName:  fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		WebIdentityToken: fwflex.StringFromFramework(ctx, data.WebIdentityToken),
+	}
+
+	durationSeconds, diags := expandDurationSeconds(data.Duration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DurationSeconds = durationSeconds
+
+	output, err := conn.AssumeRoleWithWebIdentity(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRoleWithWebIdentity, data.RoleARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if output.AssumedRoleUser != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	}
+	data.Audience = fwflex.StringToFramework(ctx, output.Audience)
+	data.IdentityProvider = fwflex.StringToFramework(ctx, output.Provider)
+	data.SourceIdentity = fwflex.StringToFramework(ctx, output.SourceIdentity)
+	data.SubjectFromWebIdentityToken = fwflex.StringToFramework(ctx, output.SubjectFromWebIdentityToken)
+
+	response.Diagnostics.Append(data.credentialsModel.flatten(output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RenewAt, diags = openCredentials(ctx, response.Private, output.Credentials)
+	response.Diagnostics.Append(diags...)
+}
+
+func (e *assumeRoleWithWebIdentityEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	var diags diag.Diagnostics
+	response.RenewAt, diags = renewCredentials(ctx, request.Private, time.Now())
+	response.Diagnostics.Append(diags...)
+}
+
+type assumeRoleWithWebIdentityEphemeralResourceModel struct {
+	framework.WithRegionModel
+	credentialsModel
+	AssumedRoleARN              types.String         `tfsdk:"assumed_role_arn"`
+	AssumedRoleID               types.String         `tfsdk:"assumed_role_id"`
+	Audience                    types.String         `tfsdk:"audience"`
+	Duration                    timetypes.GoDuration `tfsdk:"duration"`
+	IdentityProvider            types.String         `tfsdk:"identity_provider"`
+	Policy                      fwtypes.IAMPolicy    `tfsdk:"policy"`
+	PolicyARNs                  fwtypes.SetOfARN     `tfsdk:"policy_arns"`
+	ProviderID                  types.String         `tfsdk:"provider_id"`
+	RoleARN                     fwtypes.ARN          `tfsdk:"role_arn"`
+	RoleSessionName             types.String         `tfsdk:"role_session_name"`
+	SourceIdentity              types.String         `tfsdk:"source_identity"`
+	SubjectFromWebIdentityToken types.String         `tfsdk:"subject_from_web_identity_token"`
+	WebIdentityToken            types.String         `tfsdk:"web_identity_token"`
+}
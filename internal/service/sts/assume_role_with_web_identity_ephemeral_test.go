@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSTSAssumeRoleWithWebIdentityEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	roleARN := acctest.SkipIfEnvVarNotSet(t, "AWS_STS_WEB_IDENTITY_ROLE_ARN")
+	token := acctest.SkipIfEnvVarNotSet(t, "AWS_STS_WEB_IDENTITY_TOKEN")
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleWithWebIdentityEphemeralResourceConfig_basic(roleARN, token),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_arn"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("audience"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("identity_provider"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("role_session_name"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_access_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("session_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("subject_from_web_identity_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleWithWebIdentityEphemeral_invalidToken(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssumeRoleWithWebIdentityEphemeralResourceConfig_invalidToken(rName),
+				ExpectError: regexache.MustCompile(`InvalidIdentityToken`),
+			},
+		},
+	})
+}
+
+func testAccAssumeRoleWithWebIdentityEphemeralResourceConfig_basic(roleARN, token string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role_with_web_identity.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role_with_web_identity" "test" {
+  role_arn           = %[1]q
+  web_identity_token = %[2]q
+  duration           = "15m"
+}
+`, roleARN, token))
+}
+
+func testAccAssumeRoleWithWebIdentityEphemeralResourceConfig_invalidToken(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role_with_web_identity.test"),
+		fmt.Sprintf(`
+resource "aws_iam_openid_connect_provider" "test" {
+  url             = "https://accounts.testle.com/%[1]s"
+  client_id_list  = [%[1]q]
+  thumbprint_list = ["cf23df2207d99a74fbe169e3eba035e633b65d94"]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRoleWithWebIdentity"
+      Effect = "Allow"
+      Principal = {
+        Federated = aws_iam_openid_connect_provider.test.arn
+      }
+    }]
+  })
+}
+
+ephemeral "aws_sts_assume_role_with_web_identity" "test" {
+  role_arn           = aws_iam_role.test.arn
+  web_identity_token = "not-a-jwt"
+}
+`, rName))
+}
@@ -0,0 +1,10 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+// Exports for use in tests only.
+var (
+	OpenCredentials  = openCredentials
+	RenewCredentials = renewCredentials
+)
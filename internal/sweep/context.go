@@ -8,14 +8,55 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
+// sweeperInfo identifies the sweeper run that a context belongs to.
+type sweeperInfo struct {
+	name   string
+	region string
+}
+
+var sweeperInfoKey = inttypes.NewContextKey[sweeperInfo]()
+
+// Context returns a new context for running a sweeper in the specified region.
+// The context does not identify the sweeper. Sweepers registered with AddContextSweeper are passed a context which does.
 func Context(region string) context.Context {
+	return newContext("", region)
+}
+
+// newContext returns a new context for running the named sweeper in the specified region.
+func newContext(name, region string) context.Context {
 	ctx := context.Background()
 
 	ctx = tfsdklog.ContextWithStandardLogging(ctx, "sweep")
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = sweeperInfoKey.NewContext(ctx, sweeperInfo{
+		name:   name,
+		region: region,
+	})
+
 	return ctx
 }
+
+// sweeperFromContext returns the name and region of the sweeper the context belongs to.
+// The name is empty if the sweeper cannot be identified.
+func sweeperFromContext(ctx context.Context) (string, string) {
+	info := sweeperInfoKey.FromContext(ctx)
+
+	return info.name, info.region
+}
+
+var deletingKey = inttypes.NewContextKey[bool]()
+
+// withDeleting returns a new context for deleting a resource selected by SweepOrchestrator.
+func withDeleting(ctx context.Context) context.Context {
+	return deletingKey.NewContext(ctx, true)
+}
+
+// isDeleting reports whether the context is for deleting a resource selected by SweepOrchestrator.
+func isDeleting(ctx context.Context) bool {
+	return deletingKey.FromContext(ctx)
+}
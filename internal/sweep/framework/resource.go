@@ -5,6 +5,9 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -46,69 +50,188 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+func (sr *sweepResource) ID() string {
+	values := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		value := attributeString(attr.value)
+		if attr.path == names.AttrID {
+			return value
+		}
+		values = append(values, value)
+	}
+
+	return strings.Join(values, ",")
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, schema, err := sr.newResource(ctx)
 	if err != nil {
 		return err
 	}
 
+	for _, attr := range sr.attributes {
+		ctx = tflog.SetField(ctx, attr.path, attributeString(attr.value))
+	}
+
+	state, err := sr.newState(ctx, schema)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Sweeping resource")
+
+	err = deleteResource(ctx, state, resource)
+
+	if errs.Contains(err, "Value Conversion Error") {
+		// Hack for per-resource Region override.
+		// Inject a top-level region attribute into the schema and retry.
+		state, err = sr.newState(ctx, withRegionAttribute(schema))
+		if err != nil {
+			return err
+		}
+
+		err = deleteResource(ctx, state, resource)
+	}
+
+	return err
+}
+
+// Describe reads the resource, returning its name, tags and creation time where the resource has those attributes.
+func (sr *sweepResource) Describe(ctx context.Context) (describe.Description, error) {
+	var description describe.Description
+
+	resource, schema, err := sr.newResource(ctx)
+	if err != nil {
+		return description, err
+	}
+
+	state, err := sr.newState(ctx, schema)
+	if err != nil {
+		return description, err
+	}
+
+	state, err = readResource(ctx, state, resource)
+
+	if errs.Contains(err, "Value Conversion Error") {
+		state, err = sr.newState(ctx, withRegionAttribute(schema))
+		if err != nil {
+			return description, err
+		}
+
+		state, err = readResource(ctx, state, resource)
+	}
+
+	if err != nil {
+		return description, err
+	}
+
+	if state.Raw.IsNull() {
+		return description, tfresource.NewEmptyResultError()
+	}
+
+	if v, ok := stateAttribute(state, names.AttrName); ok {
+		var name *string
+		if v.As(&name) == nil {
+			description.Name = aws.ToString(name)
+		}
+	}
+
+	for _, key := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := stateAttribute(state, key); ok && !v.IsNull() {
+			var tags map[string]tftypes.Value
+			if v.As(&tags) == nil {
+				description.Tags = make(map[string]string, len(tags))
+				for k, v := range tags {
+					var s *string
+					if v.As(&s) == nil {
+						description.Tags[k] = aws.ToString(s)
+					}
+				}
+				break
+			}
+		}
+	}
+
+	for _, key := range describe.CreatedAtAttributes {
+		if v, ok := stateAttribute(state, key); ok {
+			var s *string
+			if v.As(&s) == nil {
+				if t := describe.ParseTime(aws.ToString(s)); !t.IsZero() {
+					description.CreatedAt = t
+					break
+				}
+			}
+		}
+	}
+
+	return description, nil
+}
+
+func (sr *sweepResource) newResource(ctx context.Context) (fwresource.ResourceWithConfigure, rschema.Schema, error) {
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return nil, rschema.Schema{}, err
+	}
+
 	var configureResp fwresource.ConfigureResponse
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &configureResp)
 	if configureResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(configureResp.Diagnostics)
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(configureResp.Diagnostics)
 	}
 
 	var schemaResp fwresource.SchemaResponse
 	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 	if schemaResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
 	}
 
+	return resource, schemaResp.Schema, nil
+}
+
+func (sr *sweepResource) newState(ctx context.Context, schema rschema.Schema) (tfsdk.State, error) {
 	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
 	}
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return state, fwdiag.DiagnosticsError(d)
 		}
-		switch v := attr.value.(type) {
-		case *string:
-			ctx = tflog.SetField(ctx, attr.path, aws.ToString(v))
+	}
 
-		default:
-			ctx = tflog.SetField(ctx, attr.path, v)
-		}
+	return state, nil
+}
+
+func withRegionAttribute(schema rschema.Schema) rschema.Schema {
+	schema.Attributes = maps.Clone(schema.Attributes)
+	schema.Attributes[names.AttrRegion] = rschema.StringAttribute{
+		Optional: true,
+		Computed: true,
 	}
 
-	tflog.Info(ctx, "Sweeping resource")
+	return schema
+}
 
-	err = deleteResource(ctx, state, resource)
+func attributeString(v any) string {
+	switch v := v.(type) {
+	case *string:
+		return aws.ToString(v)
 
-	if errs.Contains(err, "Value Conversion Error") {
-		// Hack for per-resource Region override.
-		// Inject a top-level region attribute into the schema and retry.
-		schema := state.Schema.(rschema.Schema)
-		schema.Attributes[names.AttrRegion] = rschema.StringAttribute{
-			Optional: true,
-			Computed: true,
-		}
-		state := tfsdk.State{
-			Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
-			Schema: schema,
-		}
-		for _, attr := range sr.attributes {
-			d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-			if d.HasError() {
-				return fwdiag.DiagnosticsError(d)
-			}
-		}
+	default:
+		return fmt.Sprint(v)
+	}
+}
 
-		err = deleteResource(ctx, state, resource)
+func stateAttribute(state tfsdk.State, name string) (tftypes.Value, bool) {
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return tftypes.Value{}, false
 	}
 
-	return err
+	value, ok := v.(tftypes.Value)
+
+	return value, ok && value.IsKnown()
 }
 
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
@@ -117,3 +240,10 @@ func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.
 
 	return fwdiag.DiagnosticsError(response.Diagnostics)
 }
+
+func readResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) (tfsdk.State, error) {
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	return response.State, fwdiag.DiagnosticsError(response.Diagnostics)
+}
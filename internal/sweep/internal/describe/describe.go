@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package describe
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Description describes a resource to be swept.
+type Description struct {
+	Name      string
+	Tags      map[string]string
+	CreatedAt time.Time
+}
+
+// CreatedAtAttributes are the names of attributes commonly holding the time that a resource was created.
+var CreatedAtAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreateTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	"create_date",
+}
+
+// ParseTime parses a creation time attribute value.
+// Returns the zero time if the value cannot be parsed.
+func ParseTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, time.DateTime, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
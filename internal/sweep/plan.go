@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// Description describes a resource to be swept.
+type Description = describe.Description
+
+// Describable is implemented by Sweepables which can identify and describe the resource they delete.
+// Only Describable resources can be filtered, planned or applied from a plan.
+type Describable interface {
+	Sweepable
+
+	// ID returns the identifier of the resource.
+	ID() string
+	// Describe reads the resource, returning its name, tags and creation time where available.
+	Describe(ctx context.Context) (Description, error)
+}
+
+// ResourceFilter selects the resources that sweepers delete.
+// A resource is selected only if it matches every criterion that is set.
+type ResourceFilter struct {
+	// MinAge selects resources created at least this long ago.
+	MinAge time.Duration
+	// NamePrefixes selects resources whose name, or ID if the resource has no name, starts with any of the prefixes.
+	NamePrefixes []string
+	// Tags selects resources with all of the tags. An empty value matches any value.
+	Tags map[string]string
+}
+
+func (f ResourceFilter) isEmpty() bool {
+	return f.MinAge == 0 && len(f.NamePrefixes) == 0 && len(f.Tags) == 0
+}
+
+func (f ResourceFilter) match(item PlanItem, now time.Time) bool {
+	if f.MinAge > 0 && (item.CreatedAt == nil || now.Sub(*item.CreatedAt) < f.MinAge) {
+		return false
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := item.Name
+		if name == "" {
+			name = item.ID
+		}
+		if !slices.ContainsFunc(f.NamePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		}) {
+			return false
+		}
+	}
+
+	for k, v := range f.Tags {
+		if tag, ok := item.Tags[k]; !ok || (v != "" && tag != v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ExpandTags parses comma-separated "key=value" pairs. A key without a value matches any value.
+func ExpandTags(s string) map[string]string {
+	tags := make(map[string]string)
+	for tag := range strings.SplitSeq(s, ",") {
+		if tag = strings.TrimSpace(tag); tag == "" {
+			continue
+		}
+		k, v, _ := strings.Cut(tag, "=")
+		tags[k] = v
+	}
+
+	return tags
+}
+
+// Plan lists the resources that sweepers would delete.
+type Plan struct {
+	Items []PlanItem `json:"items"`
+}
+
+// PlanItem identifies a resource that a sweeper would delete.
+type PlanItem struct {
+	Type      string            `json:"type"`
+	ID        string            `json:"id"`
+	Region    string            `json:"region"`
+	Name      string            `json:"name,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+func (item PlanItem) key() string {
+	return item.Type + "\x00" + item.Region + "\x00" + item.ID
+}
+
+// ReadPlan reads a plan from a JSON file.
+func ReadPlan(filename string) (*Plan, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading sweeper plan: %w", err)
+	}
+
+	var plan Plan
+	if err := json.Unmarshal(b, &plan); err != nil {
+		return nil, fmt.Errorf("reading sweeper plan (%s): %w", filename, err)
+	}
+
+	return &plan, nil
+}
+
+// WritePlan writes a plan to a JSON file.
+func WritePlan(filename string, plan *Plan) error {
+	b, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, b, 0o644); err != nil {
+		return fmt.Errorf("writing sweeper plan: %w", err)
+	}
+
+	return nil
+}
+
+// sweepMode controls how SweepOrchestrator handles the resources listed by sweepers.
+// It is set once before any sweepers run.
+type sweepMode struct {
+	// dryRun records resources in plan instead of deleting them.
+	dryRun bool
+	filter ResourceFilter
+	// apply, if set, holds the keys of the only resources which may be deleted.
+	apply map[string]struct{}
+
+	mutex sync.Mutex
+	plan  Plan
+}
+
+var mode = &sweepMode{}
+
+// isPlanned reports whether resources must be identified by sweeper and region.
+func (m *sweepMode) isPlanned() bool {
+	return m.dryRun || m.apply != nil
+}
+
+// isActive reports whether resources must be described or identified before deletion.
+func (m *sweepMode) isActive() bool {
+	return m.isPlanned() || !m.filter.isEmpty()
+}
+
+func (m *sweepMode) addToPlan(items ...PlanItem) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.plan.Items = append(m.plan.Items, items...)
+}
+
+// selectSweepables returns the sweepables to delete, recording them in the plan in dry-run mode.
+// The sweeper and region are identified by the context.
+func (m *sweepMode) selectSweepables(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	sweeperName, region := sweeperFromContext(ctx)
+
+	if m.isPlanned() && sweeperName == "" {
+		tflog.Warn(ctx, "Skipping resources of sweeper which cannot be identified", map[string]any{
+			"resource_count": len(sweepables),
+		})
+		return nil, nil
+	}
+
+	needsDescription := m.dryRun || !m.filter.isEmpty()
+	now := time.Now()
+
+	var (
+		g        tfsync.Group
+		mutex    sync.Mutex
+		selected []Sweepable
+		items    []PlanItem
+	)
+
+	for _, sweepable := range sweepables {
+		v, ok := sweepable.(Describable)
+		if !ok {
+			tflog.Warn(ctx, "Skipping resource which cannot be identified", map[string]any{
+				"type": fmt.Sprintf("%T", sweepable),
+			})
+			continue
+		}
+
+		g.Go(ctx, func(ctx context.Context) error {
+			item := PlanItem{
+				Type:   sweeperName,
+				ID:     v.ID(),
+				Region: region,
+			}
+
+			if m.apply != nil {
+				if _, ok := m.apply[item.key()]; !ok {
+					return nil
+				}
+			}
+
+			if needsDescription {
+				description, err := v.Describe(ctx)
+
+				if retry.NotFound(err) {
+					return nil
+				}
+
+				if err != nil {
+					return fmt.Errorf("describing %s (%s): %w", item.Type, item.ID, err)
+				}
+
+				item.Name = description.Name
+				item.Tags = description.Tags
+				if !description.CreatedAt.IsZero() {
+					item.CreatedAt = &description.CreatedAt
+				}
+
+				if !m.filter.match(item, now) {
+					return nil
+				}
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			selected = append(selected, sweepable)
+			items = append(items, item)
+
+			return nil
+		})
+	}
+
+	err := g.Wait(ctx)
+
+	if m.dryRun {
+		for _, item := range items {
+			tflog.Info(ctx, "Would sweep resource", map[string]any{
+				"type": item.Type,
+				"id":   item.ID,
+			})
+		}
+		m.addToPlan(items...)
+
+		return nil, err
+	}
+
+	return selected, err
+}
+
+// legacySweeperFunc returns a sweeper function which skips the sweeper in dry-run or plan apply mode or when filtering resources.
+// Sweepers registered with AddTestSweepers may delete resources directly rather than through SweepOrchestrator,
+// so the resources they delete cannot be planned or filtered.
+func (m *sweepMode) legacySweeperFunc(name string, f func(string) error) func(string) error {
+	return func(region string) error {
+		if m.isActive() {
+			tflog.Warn(newContext(name, region), "Skipping sweeper which does not support dry runs, plans or resource filters", map[string]any{
+				"sweeper": name,
+			})
+			return nil
+		}
+
+		return f(region)
+	}
+}
+
+// newGuardAPIOption returns an API option which fails any AWS API operation that may modify resources,
+// unless it is made while deleting a resource selected by SweepOrchestrator.
+func newGuardAPIOption() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFSweepGuard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			if !guardPermits(ctx, operation) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("%s.%s: not permitted except when deleting a resource selected for sweeping", awsmiddleware.GetServiceID(ctx), operation)
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.Before)
+	}
+}
+
+// guardPermits reports whether an AWS API operation is permitted in dry-run or plan apply mode or when filtering resources.
+func guardPermits(ctx context.Context, operation string) bool {
+	return isDeleting(ctx) || slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operation, prefix)
+	})
+}
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names which do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// sortPlanItems sorts plan items by region, type and ID.
+func sortPlanItems(items []PlanItem) {
+	slices.SortFunc(items, func(a, b PlanItem) int {
+		return strings.Compare(a.Region+"\x00"+a.Type+"\x00"+a.ID, b.Region+"\x00"+b.Type+"\x00"+b.ID)
+	})
+}
+
+func planKeys(plan *Plan) map[string]struct{} {
+	keys := make(map[string]struct{}, len(plan.Items))
+	for _, item := range plan.Items {
+		keys[item.key()] = struct{}{}
+	}
+
+	return keys
+}
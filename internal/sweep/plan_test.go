@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id          string
+	description Description
+	err         error
+}
+
+func (s *testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (s *testSweepable) ID() string {
+	return s.id
+}
+
+func (s *testSweepable) Describe(context.Context) (Description, error) {
+	return s.description, s.err
+}
+
+type testUndescribableSweepable struct{}
+
+func (s *testUndescribableSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func TestResourceFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	created := now.Add(-36 * time.Hour)
+	item := PlanItem{
+		Type:      "aws_example_thing",
+		ID:        "thing-12345678",
+		Region:    "us-west-2",
+		Name:      "tf-acc-test-12345",
+		CreatedAt: &created,
+		Tags:      map[string]string{"Owner": "sandbox", "Environment": "test"},
+	}
+
+	testCases := map[string]struct {
+		filter   ResourceFilter
+		item     PlanItem
+		expected bool
+	}{
+		"empty": {
+			item:     item,
+			expected: true,
+		},
+		"name prefix": {
+			filter:   ResourceFilter{NamePrefixes: []string{"other-", "tf-acc-test-"}},
+			item:     item,
+			expected: true,
+		},
+		"name prefix no match": {
+			filter: ResourceFilter{NamePrefixes: []string{"other-"}},
+			item:   item,
+		},
+		"name prefix matches ID without name": {
+			filter:   ResourceFilter{NamePrefixes: []string{"thing-"}},
+			item:     PlanItem{ID: "thing-12345678"},
+			expected: true,
+		},
+		"tags": {
+			filter:   ResourceFilter{Tags: map[string]string{"Owner": "sandbox", "Environment": ""}},
+			item:     item,
+			expected: true,
+		},
+		"tag value no match": {
+			filter: ResourceFilter{Tags: map[string]string{"Owner": "production"}},
+			item:   item,
+		},
+		"tag missing": {
+			filter: ResourceFilter{Tags: map[string]string{"Team": ""}},
+			item:   item,
+		},
+		"min age": {
+			filter:   ResourceFilter{MinAge: 24 * time.Hour},
+			item:     item,
+			expected: true,
+		},
+		"min age too new": {
+			filter: ResourceFilter{MinAge: 48 * time.Hour},
+			item:   item,
+		},
+		"min age unknown creation time": {
+			filter: ResourceFilter{MinAge: time.Hour},
+			item:   PlanItem{ID: "thing-12345678"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.match(testCase.item, now), testCase.expected; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandTags(t *testing.T) {
+	t.Parallel()
+
+	got := ExpandTags("Owner=sandbox, Environment,")
+	expected := map[string]string{"Owner": "sandbox", "Environment": ""}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepModeSelectSweepables(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	old := &testSweepable{id: "thing-1", description: Description{Name: "tf-acc-test-1", Tags: map[string]string{"Owner": "sandbox"}}}
+	other := &testSweepable{id: "thing-2", description: Description{Name: "tf-acc-test-2", Tags: map[string]string{"Owner": "production"}}}
+	gone := &testSweepable{id: "thing-3", err: tfresource.NewEmptyResultError()}
+	sweepables := []Sweepable{old, other, gone, &testUndescribableSweepable{}}
+
+	ctx = sweeperInfoKey.NewContext(ctx, sweeperInfo{name: "aws_example_thing", region: "us-west-2"})
+
+	dryRun := &sweepMode{
+		dryRun: true,
+		filter: ResourceFilter{Tags: map[string]string{"Owner": "sandbox"}},
+	}
+
+	selected, err := dryRun.selectSweepables(ctx, sweepables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 0 {
+		t.Errorf("dry run selected %d resources for deletion", len(selected))
+	}
+
+	expected := Plan{
+		Items: []PlanItem{
+			{Type: "aws_example_thing", ID: "thing-1", Region: "us-west-2", Name: "tf-acc-test-1", Tags: map[string]string{"Owner": "sandbox"}},
+		},
+	}
+	if diff := cmp.Diff(dryRun.plan, expected); diff != "" {
+		t.Errorf("unexpected plan diff (+wanted, -got): %s", diff)
+	}
+
+	filename := filepath.Join(t.TempDir(), "plan.json")
+	if err := WritePlan(filename, &dryRun.plan); err != nil {
+		t.Fatal(err)
+	}
+	plan, err := ReadPlan(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apply := &sweepMode{
+		apply: planKeys(plan),
+	}
+
+	selected, err = apply.selectSweepables(ctx, sweepables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(selected, []Sweepable{old}, cmp.AllowUnexported(testSweepable{})); diff != "" {
+		t.Errorf("unexpected selection diff (+wanted, -got): %s", diff)
+	}
+
+	selected, err = apply.selectSweepables(sweeperInfoKey.NewContext(ctx, sweeperInfo{name: "aws_example_thing", region: "us-east-1"}), sweepables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 0 {
+		t.Errorf("applying plan in another region selected %d resources for deletion", len(selected))
+	}
+
+	selected, err = apply.selectSweepables(sweeperInfoKey.NewContext(ctx, sweeperInfo{region: "us-west-2"}), sweepables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 0 {
+		t.Errorf("applying plan for an unidentified sweeper selected %d resources for deletion", len(selected))
+	}
+}
+
+func TestContextSweeperName(t *testing.T) {
+	t.Parallel()
+
+	if name, region := sweeperFromContext(Context("us-west-2")); name != "" || region != "us-west-2" {
+		t.Errorf("sweeper = (%q, %q), want (%q, %q)", name, region, "", "us-west-2")
+	}
+
+	if name, region := sweeperFromContext(newContext("aws_example_thing", "us-west-2")); name != "aws_example_thing" || region != "us-west-2" {
+		t.Errorf("sweeper = (%q, %q), want (%q, %q)", name, region, "aws_example_thing", "us-west-2")
+	}
+}
+
+func TestGuardPermits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		ctx       context.Context
+		operation string
+		expected  bool
+	}{
+		"read": {
+			ctx:       ctx,
+			operation: "DescribeInstances",
+			expected:  true,
+		},
+		"delete": {
+			ctx:       ctx,
+			operation: "DeleteSecurityGroup",
+		},
+		"delete selected resource": {
+			ctx:       withDeleting(ctx),
+			operation: "DeleteSecurityGroup",
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := guardPermits(testCase.ctx, testCase.operation), testCase.expected; got != want {
+				t.Errorf("guardPermits = %t, want %t", got, want)
+			}
+		})
+	}
+}
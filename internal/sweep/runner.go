@@ -0,0 +1,365 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// DefaultParallelism is the default maximum number of sweepers run concurrently in each region.
+const DefaultParallelism = 10
+
+// registeredSweepers holds all registered sweepers, keyed by name.
+var registeredSweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper to be run when the -sweep flag is used with `go test`.
+// Sweeper names must be unique.
+//
+// The sweeper function may delete resources directly, so the sweeper is skipped in dry-run or plan apply mode
+// or when filtering resources. Use AddContextSweeper for sweepers which support these modes.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweeper := *s
+	sweeper.F = mode.legacySweeperFunc(name, s.F)
+
+	addSweeper(name, &sweeper)
+}
+
+// AddContextSweeper registers a sweeper to be run when the -sweep flag is used with `go test`.
+// Sweeper names must be unique.
+//
+// The sweeper function is passed a context identifying the sweeper, which must be used when calling SweepOrchestrator.
+// The sweeper must delete resources only through SweepOrchestrator, so that the resources it deletes can be planned and filtered.
+func AddContextSweeper(name string, f func(ctx context.Context, region string) error, dependencies ...string) {
+	addSweeper(name, &resource.Sweeper{
+		Name: name,
+		F: func(region string) error {
+			return f(newContext(name, region), region)
+		},
+		Dependencies: dependencies,
+	})
+}
+
+func addSweeper(name string, s *resource.Sweeper) {
+	if _, ok := registeredSweepers[name]; ok {
+		panic(fmt.Sprintf("sweeper (%s) already registered", name))
+	}
+
+	registeredSweepers[name] = s
+}
+
+// SkippedError is returned for a sweeper that was not run because a sweeper it depends on failed or was skipped,
+// or because another sweeper failed and failures are not allowed.
+type SkippedError struct {
+	// Dependency is the name of the sweeper dependency that failed or was skipped.
+	// Empty if the sweeper was skipped because another sweeper failed.
+	Dependency string
+}
+
+func (e *SkippedError) Error() string {
+	if e.Dependency == "" {
+		return "skipped after an earlier sweeper failed"
+	}
+
+	return fmt.Sprintf("skipped because dependency (%s) did not complete", e.Dependency)
+}
+
+// RunOptions configures a sweeper run.
+type RunOptions struct {
+	// AllowFailures continues starting new sweepers after a sweeper fails.
+	AllowFailures bool
+	// ApplyPlanFile, if set, is a plan file written by a dry run. Only the resources in the plan are deleted.
+	ApplyPlanFile string
+	// DryRunPlanFile, if set, enables dry-run mode. Sweepers list resources but do not delete them,
+	// and the resources that would be deleted are written to the plan file.
+	DryRunPlanFile string
+	// Filter is a comma-separated list of sweeper names to run. Matching is by case-insensitive substring.
+	Filter string
+	// Parallelism is the maximum number of sweepers run concurrently in each region.
+	Parallelism int
+	// ResourceFilter selects the resources that sweepers delete.
+	ResourceFilter ResourceFilter
+}
+
+// RunSweepers runs the registered sweepers in each of the specified regions.
+//
+// The sweepers to run are those whose name contains, case-insensitively, any of the comma-separated
+// names in the filter, together with their dependencies. All sweepers are run if the filter is empty.
+// In each region, up to the configured number of sweepers whose dependencies have completed are run concurrently.
+// A sweeper whose dependency fails is skipped. Unless failures are allowed, no new sweepers are started
+// after a sweeper fails.
+func RunSweepers(regions []string, opts RunOptions) error {
+	if opts.DryRunPlanFile != "" && opts.ApplyPlanFile != "" {
+		return errors.New("a sweeper plan cannot be both written and applied")
+	}
+
+	mode.dryRun = opts.DryRunPlanFile != ""
+	mode.filter = opts.ResourceFilter
+
+	if opts.ApplyPlanFile != "" {
+		plan, err := ReadPlan(opts.ApplyPlanFile)
+		if err != nil {
+			return err
+		}
+
+		mode.apply = planKeys(plan)
+	}
+
+	_, err := runSweepers(regions, registeredSweepers, opts)
+
+	if mode.dryRun {
+		sortPlanItems(mode.plan.Items)
+
+		if writeErr := WritePlan(opts.DryRunPlanFile, &mode.plan); writeErr != nil {
+			return errors.Join(err, writeErr)
+		}
+
+		log.Printf("[INFO] Sweeper plan with %d resources written to %s", len(mode.plan.Items), opts.DryRunPlanFile)
+	}
+
+	return err
+}
+
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, opts RunOptions) (map[string]map[string]error, error) {
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := filterSweepers(g, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var sweeperErrorFound bool
+	results := make(map[string]map[string]error)
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		ctx := Context(region)
+
+		start := time.Now()
+		tflog.Info(ctx, "Running sweepers", map[string]any{
+			"sweeper_count": len(names),
+			"parallelism":   opts.Parallelism,
+		})
+
+		regionResults := runSweepersInRegion(region, g, sweepers, names, opts)
+		results[region] = regionResults
+
+		tflog.Info(ctx, "Completed sweepers", map[string]any{
+			"duration": time.Since(start).String(),
+		})
+
+		for _, name := range names {
+			err := regionResults[name]
+
+			if err == nil {
+				tflog.Info(ctx, "Sweeper succeeded", map[string]any{
+					"sweeper": name,
+				})
+				continue
+			}
+
+			sweeperErrorFound = true
+
+			if skippedErr, ok := errs.As[*SkippedError](err); ok {
+				tflog.Warn(ctx, "Sweeper skipped", map[string]any{
+					"sweeper": name,
+					"reason":  skippedErr.Error(),
+				})
+				continue
+			}
+
+			tflog.Error(ctx, "Sweeper failed", map[string]any{
+				"sweeper": name,
+				"error":   err.Error(),
+			})
+		}
+
+		if sweeperErrorFound && !opts.AllowFailures {
+			return results, fmt.Errorf("sweepers for region (%s) failed", region)
+		}
+	}
+
+	if sweeperErrorFound {
+		return results, errors.New("at least one sweeper failed")
+	}
+
+	return results, nil
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// Returns an error if a sweeper depends on an unregistered sweeper or a dependency cycle is detected.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := sortedSweeperNames(sweepers)
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	var dependencyErrs []error
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				dependencyErrs = append(dependencyErrs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+
+	if err := errors.Join(dependencyErrs...); err != nil {
+		return nil, err
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("sweepers: %w", err)
+	}
+
+	return g, nil
+}
+
+// filterSweepers returns the sorted names of the sweepers in the graph matching the comma-separated filter,
+// together with their dependencies.
+func filterSweepers(g *depgraph.Graph, filter string) ([]string, error) {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []string
+	for f := range strings.SplitSeq(strings.ToLower(filter), ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			filters = append(filters, f)
+		}
+	}
+
+	if len(filters) == 0 {
+		slices.Sort(order)
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+	for _, name := range order {
+		if !slices.ContainsFunc(filters, func(f string) bool {
+			return strings.Contains(strings.ToLower(name), f)
+		}) {
+			continue
+		}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		selected[name] = struct{}{}
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(selected))
+	for name := range selected {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// runSweepersInRegion runs the named sweepers in a region, each after all of its dependencies have completed.
+// Dependencies of the named sweepers must also be named.
+// In dry-run mode, sweepers are run even if a dependency fails, as no resources are deleted.
+func runSweepersInRegion(region string, g *depgraph.Graph, sweepers map[string]*resource.Sweeper, names []string, opts RunOptions) map[string]error {
+	type sweeperRun struct {
+		done chan struct{}
+		err  error
+	}
+
+	runs := make(map[string]*sweeperRun, len(names))
+	for _, name := range names {
+		runs[name] = &sweeperRun{
+			done: make(chan struct{}),
+		}
+	}
+
+	ctx := Context(region)
+	semaphore := make(chan struct{}, max(opts.Parallelism, 1))
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+
+	for _, name := range names {
+		run := runs[name]
+		dependencies, _ := g.DirectDependenciesOf(name)
+
+		wg.Go(func() {
+			defer close(run.done)
+
+			for _, dependency := range dependencies {
+				dependencyRun := runs[dependency]
+				<-dependencyRun.done
+
+				if dependencyRun.err != nil && !mode.dryRun {
+					run.err = &SkippedError{Dependency: dependency}
+					return
+				}
+			}
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if !opts.AllowFailures && failed.Load() {
+				run.err = &SkippedError{}
+				return
+			}
+
+			tflog.Debug(ctx, "Running sweeper", map[string]any{
+				"sweeper": name,
+			})
+
+			start := time.Now()
+			run.err = sweepers[name].F(region)
+
+			tflog.Debug(ctx, "Completed sweeper", map[string]any{
+				"sweeper":  name,
+				"duration": time.Since(start).String(),
+			})
+
+			if run.err != nil {
+				failed.Store(true)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	results := make(map[string]error, len(runs))
+	for name, run := range runs {
+		results[name] = run.err
+	}
+
+	return results
+}
+
+func sortedSweeperNames(sweepers map[string]*resource.Sweeper) []string {
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dependencies  map[string][]string
+		expectedError bool
+	}{
+		"no dependencies": {
+			dependencies: map[string][]string{
+				"aws_a": nil,
+				"aws_b": nil,
+			},
+		},
+		"dependencies": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b", "aws_c"},
+				"aws_b": {"aws_c"},
+				"aws_c": nil,
+			},
+		},
+		"missing dependency": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b"},
+			},
+			expectedError: true,
+		},
+		"dependency cycle": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_c"},
+				"aws_c": {"aws_a"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sweepers := make(map[string]*resource.Sweeper)
+			for name, dependencies := range testCase.dependencies {
+				sweepers[name] = &resource.Sweeper{Name: name, Dependencies: dependencies}
+			}
+
+			_, err := sweeperGraph(sweepers)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_example_thing":       {Name: "aws_example_thing", Dependencies: []string{"aws_example_other_thing"}},
+		"aws_example_other_thing": {Name: "aws_example_other_thing", Dependencies: []string{"aws_vpc"}},
+		"aws_vpc":                 {Name: "aws_vpc"},
+		"aws_subnet":              {Name: "aws_subnet"},
+	}
+
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"no filter": {
+			expected: []string{"aws_example_other_thing", "aws_example_thing", "aws_subnet", "aws_vpc"},
+		},
+		"with dependencies": {
+			filter:   "aws_example_thing",
+			expected: []string{"aws_example_other_thing", "aws_example_thing", "aws_vpc"},
+		},
+		"multiple": {
+			filter:   "SUBNET,aws_vpc",
+			expected: []string{"aws_subnet", "aws_vpc"},
+		},
+		"no match": {
+			filter:   "aws_instance",
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filterSweepers(g, testCase.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepersInRegion(t *testing.T) {
+	t.Parallel()
+
+	const parallelism = 2
+
+	var (
+		mutex            sync.Mutex
+		order            []string
+		running, maxSeen atomic.Int32
+	)
+	sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(string) error {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					if m := maxSeen.Load(); n <= m || maxSeen.CompareAndSwap(m, n) {
+						break
+					}
+				}
+
+				mutex.Lock()
+				order = append(order, name)
+				mutex.Unlock()
+
+				return err
+			},
+		}
+	}
+
+	errFailed := errors.New("failed")
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": sweeper("aws_a", nil, "aws_b", "aws_c"),
+		"aws_b": sweeper("aws_b", nil, "aws_d"),
+		"aws_c": sweeper("aws_c", nil),
+		"aws_d": sweeper("aws_d", nil),
+		"aws_e": sweeper("aws_e", errFailed),
+		"aws_f": sweeper("aws_f", nil, "aws_g"),
+		"aws_g": sweeper("aws_g", nil, "aws_e"),
+		"aws_h": sweeper("aws_h", nil),
+	}
+
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := filterSweepers(g, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runSweepersInRegion("us-west-2", g, sweepers, names, RunOptions{AllowFailures: true, Parallelism: parallelism})
+
+	for _, name := range []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_h"} {
+		if err := results[name]; err != nil {
+			t.Errorf("sweeper (%s): unexpected error: %s", name, err)
+		}
+	}
+	if err := results["aws_e"]; !errors.Is(err, errFailed) {
+		t.Errorf("sweeper (aws_e): expected failure, got: %v", err)
+	}
+	for name, dependency := range map[string]string{"aws_g": "aws_e", "aws_f": "aws_g"} {
+		skippedErr, ok := errs.As[*SkippedError](results[name])
+		if !ok {
+			t.Errorf("sweeper (%s): expected to be skipped, got: %v", name, results[name])
+			continue
+		}
+		if got, want := skippedErr.Dependency, dependency; got != want {
+			t.Errorf("sweeper (%s): skipped dependency = %s, want %s", name, got, want)
+		}
+	}
+
+	if slices.Contains(order, "aws_f") || slices.Contains(order, "aws_g") {
+		t.Errorf("skipped sweepers were run: %v", order)
+	}
+	for name, dependencies := range map[string][]string{"aws_a": {"aws_b", "aws_c", "aws_d"}, "aws_b": {"aws_d"}} {
+		for _, dependency := range dependencies {
+			if slices.Index(order, dependency) > slices.Index(order, name) {
+				t.Errorf("sweeper (%s) ran before dependency (%s): %v", name, dependency, order)
+			}
+		}
+	}
+	if got := maxSeen.Load(); got > parallelism {
+		t.Errorf("%d sweepers ran concurrently, want at most %d", got, parallelism)
+	}
+}
+
+func TestRunSweepersInRegion_disallowFailures(t *testing.T) {
+	t.Parallel()
+
+	var ran atomic.Int32
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", F: func(string) error {
+			ran.Add(1)
+			return errors.New("failed")
+		}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}, F: func(string) error {
+			ran.Add(1)
+			return nil
+		}},
+		"aws_c": {Name: "aws_c", Dependencies: []string{"aws_b"}, F: func(string) error {
+			ran.Add(1)
+			return nil
+		}},
+	}
+
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runSweepersInRegion("us-west-2", g, sweepers, []string{"aws_a", "aws_b", "aws_c"}, RunOptions{Parallelism: 1})
+
+	if got, want := ran.Load(), int32(1); got != want {
+		t.Errorf("%d sweepers ran, want %d", got, want)
+	}
+	for _, name := range []string{"aws_b", "aws_c"} {
+		if _, ok := errs.As[*SkippedError](results[name]); !ok {
+			t.Errorf("sweeper (%s): expected to be skipped, got: %v", name, results[name])
+		}
+	}
+}
+
+func TestRunSweepersInRegion_applyLegacySweeper(t *testing.T) {
+	t.Parallel()
+
+	m := &sweepMode{
+		apply: map[string]struct{}{},
+	}
+
+	var deleted atomic.Int32
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", F: m.legacySweeperFunc("aws_a", func(string) error {
+			// Deletes resources directly rather than through SweepOrchestrator.
+			deleted.Add(1)
+			return nil
+		})},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}, F: func(string) error {
+			return nil
+		}},
+	}
+
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runSweepersInRegion("us-west-2", g, sweepers, []string{"aws_a", "aws_b"}, RunOptions{Parallelism: 1})
+
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("sweeper (aws_a) deleted %d resources when applying a plan", got)
+	}
+	for _, name := range []string{"aws_a", "aws_b"} {
+		if err := results[name]; err != nil {
+			t.Errorf("sweeper (%s): unexpected error: %s", name, err)
+		}
+	}
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +42,50 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
+// Describe reads the resource, returning its name, tags and creation time where the resource has those attributes.
+func (sr *sweepResource) Describe(ctx context.Context) (describe.Description, error) {
+	var description describe.Description
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return description, err
+	}
+
+	if sr.d.Id() == "" {
+		return description, tfresource.NewEmptyResultError()
+	}
+
+	if v, ok := sr.d.GetOk(names.AttrName); ok {
+		description.Name, _ = v.(string)
+	}
+
+	for _, key := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := sr.d.GetOk(key); ok {
+			if v, ok := v.(map[string]any); ok {
+				description.Tags = flex.ExpandStringValueMap(v)
+				break
+			}
+		}
+	}
+
+	for _, key := range describe.CreatedAtAttributes {
+		if v, ok := sr.d.GetOk(key); ok {
+			if v, ok := v.(string); ok {
+				if t := describe.ParseTime(v); !t.IsZero() {
+					description.CreatedAt = t
+					break
+				}
+			}
+		}
+	}
+
+	return description, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
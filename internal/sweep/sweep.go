@@ -5,6 +5,7 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -63,6 +64,10 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	if mode.isActive() {
+		conf.APIOptions = append(conf.APIOptions, newGuardAPIOption())
+	}
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -105,7 +110,12 @@ type Sweepable interface {
 }
 
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
-	if len(sweepables) == 0 {
+	var selectErr error
+	if mode.isActive() {
+		sweepables, selectErr = mode.selectSweepables(ctx, sweepables)
+	}
+
+	if len(sweepables) == 0 && !mode.dryRun {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
@@ -113,11 +123,11 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 
 	for _, sweepable := range sweepables {
 		g.Go(ctx, func(ctx context.Context) error {
-			return sweepable.Delete(ctx, optFns...)
+			return sweepable.Delete(withDeleting(ctx), optFns...)
 		})
 	}
 
-	return g.Wait(ctx)
+	return errors.Join(selectErr, g.Wait(ctx))
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -5,12 +5,26 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"log"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+// The -sweep, -sweep-run and -sweep-allow-failures flags are registered by terraform-plugin-testing.
+var (
+	flagSweepApply       = flag.String("sweep-apply", "", "Plan file written by -sweep-dry-run. Only resources in the plan are deleted")
+	flagSweepDryRun      = flag.String("sweep-dry-run", "", "Write a plan of the resources that Sweepers would delete to this file, without deleting anything")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago")
+	flagSweepNamePrefix  = flag.String("sweep-name-prefix", "", "Comma separated list of name prefixes. Only sweep resources whose name starts with one of the prefixes")
+	flagSweepParallelism = flag.Int("sweep-parallelism", sweep.DefaultParallelism, "Maximum number of Sweepers to run concurrently in each Region")
+	flagSweepTags        = flag.String("sweep-tags", "", "Comma separated list of key=value tags. Only sweep resources with all of the tags")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +32,32 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
-	resource.TestMain(m)
+	flag.Parse()
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		allowFailures, _ := strconv.ParseBool(flag.Lookup("sweep-allow-failures").Value.String())
+		opts := sweep.RunOptions{
+			AllowFailures:  allowFailures,
+			ApplyPlanFile:  *flagSweepApply,
+			DryRunPlanFile: *flagSweepDryRun,
+			Filter:         flag.Lookup("sweep-run").Value.String(),
+			Parallelism:    *flagSweepParallelism,
+			ResourceFilter: sweep.ResourceFilter{
+				MinAge: *flagSweepMinAge,
+				Tags:   sweep.ExpandTags(*flagSweepTags),
+			},
+		}
+		if *flagSweepNamePrefix != "" {
+			opts.ResourceFilter.NamePrefixes = strings.Split(*flagSweepNamePrefix, ",")
+		}
+
+		if err := sweep.RunSweepers(strings.Split(regions, ","), opts); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
 }
@@ -53,17 +53,43 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path to, or JSON content of, an organizational tag
+	// policy document
+	//
+	// When set, compliance is enforced against this document instead of the effective tag
+	// policy retrieved from AWS.
+	TagPolicyDocumentEnvVar = "TF_AWS_TAG_POLICY_DOCUMENT"
 )
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// ScopedTags contains tags to default across a subset of resource types
+	ScopedTags []ScopedDefaultConfig
+}
+
+// ScopedDefaultConfig contains tags to default across the resource types within a scope.
+type ScopedDefaultConfig struct {
+	Scope ResourceScope
+	Tags  KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+
+	// Scoped contains options for removing tags from a subset of resource types
+	Scoped []ScopedIgnoreConfig
+}
+
+// ScopedIgnoreConfig contains options for removing tags from the resource types within a scope.
+type ScopedIgnoreConfig struct {
+	Scope       ResourceScope
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -75,9 +101,40 @@ type TagPolicyConfig struct {
 	// shared across both Plugin SDK V2 and Plugin Framework based resources.
 	Severity string
 
+	// Document is the JSON content of a tag policy document to enforce
+	//
+	// When empty, the effective tag policy is retrieved from AWS.
+	Document string
+
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// TagRules is a mapping of lowercase tag keys to the capitalization and
+	// allowed values defined in the effective tag policy
+	TagRules map[string]TagPolicyRule
+}
+
+// TagPolicyRule contains the constraints an organizational tag policy places on
+// a single tag key.
+type TagPolicyRule struct {
+	// Key is the tag key with the capitalization required by the tag policy
+	//
+	// An empty value does not enforce capitalization.
+	Key string
+
+	// AllowedValues are the tag values permitted by the tag policy
+	//
+	// An empty list permits any value. A value ending in "*" matches any tag
+	// value beginning with the preceding characters.
+	AllowedValues []string
+}
+
+// TagPolicyViolation describes tags which do not comply with an organizational
+// tag policy.
+type TagPolicyViolation struct {
+	Summary string
+	Detail  string
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -95,6 +152,58 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResource returns the DefaultConfig which applies to the specified resource type,
+// merging the tags of any matching scopes over the tags defaulted across all resources.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.ScopedTags) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, v := range dc.ScopedTags {
+		if v.Scope.Matches(servicePackageName, typeName) {
+			tags = tags.Merge(v.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
+// ForResource returns the IgnoreConfig which applies to the specified resource type,
+// merging the keys and key prefixes of any matching scopes with those ignored across all resources.
+func (ic *IgnoreConfig) ForResource(servicePackageName, typeName string) *IgnoreConfig {
+	if ic == nil || len(ic.Scoped) == 0 {
+		return ic
+	}
+
+	keys, keyPrefixes := ic.Keys, ic.KeyPrefixes
+	for _, v := range ic.Scoped {
+		if v.Scope.Matches(servicePackageName, typeName) {
+			if len(v.Keys) > 0 {
+				keys = keys.Merge(v.Keys)
+			}
+			if len(v.KeyPrefixes) > 0 {
+				keyPrefixes = keyPrefixes.Merge(v.KeyPrefixes)
+			}
+		}
+	}
+
+	if len(keys) == 0 && len(keyPrefixes) == 0 {
+		return nil
+	}
+
+	return &IgnoreConfig{
+		Keys:        keys,
+		KeyPrefixes: keyPrefixes,
+	}
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -125,6 +234,84 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// NoncompliantKeys returns a description of each tag key whose capitalization
+// differs from the key defined in the tag policy.
+func (tpc *TagPolicyConfig) NoncompliantKeys(tags KeyValueTags) []string {
+	if tpc == nil {
+		return nil
+	}
+
+	var result []string
+	for k := range tags {
+		if rule, ok := tpc.TagRules[strings.ToLower(k)]; ok && rule.Key != "" && rule.Key != k {
+			result = append(result, fmt.Sprintf("%s (expected %s)", k, rule.Key))
+		}
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+// NoncompliantValues returns a description of each tag whose value is not one
+// of the values allowed by the tag policy.
+func (tpc *TagPolicyConfig) NoncompliantValues(tags KeyValueTags) []string {
+	if tpc == nil {
+		return nil
+	}
+
+	var result []string
+	for k, v := range tags {
+		rule, ok := tpc.TagRules[strings.ToLower(k)]
+		if !ok || len(rule.AllowedValues) == 0 {
+			continue
+		}
+
+		if value := v.ValueString(); !slices.ContainsFunc(rule.AllowedValues, func(allowed string) bool {
+			if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+				return strings.HasPrefix(value, prefix)
+			}
+			return value == allowed
+		}) {
+			result = append(result, fmt.Sprintf("%s=%q (allowed: %s)", k, value, strings.Join(rule.AllowedValues, ", ")))
+		}
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+// Violations returns the ways in which the given tags for a Terraform resource
+// type do not comply with the tag policy.
+func (tpc *TagPolicyConfig) Violations(typeName string, tags KeyValueTags) []TagPolicyViolation {
+	if tpc == nil {
+		return nil
+	}
+
+	var result []TagPolicyViolation
+	if reqTags := tpc.RequiredTags[typeName]; !tags.ContainsAllKeys(reqTags) {
+		missing := reqTags.Removed(tags).Keys()
+		slices.Sort(missing)
+		result = append(result, TagPolicyViolation{
+			Summary: "Missing Required Tags",
+			Detail:  fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing),
+		})
+	}
+	if v := tpc.NoncompliantKeys(tags); len(v) > 0 {
+		result = append(result, TagPolicyViolation{
+			Summary: "Noncompliant Tag Keys",
+			Detail:  fmt.Sprintf("An organizational tag policy requires different capitalization of the following tag keys for %s: %s", typeName, v),
+		})
+	}
+	if v := tpc.NoncompliantValues(tags); len(v) > 0 {
+		result = append(result, TagPolicyViolation{
+			Summary: "Noncompliant Tag Values",
+			Detail:  fmt.Sprintf("An organizational tag policy does not allow the following tag values for %s: %s", typeName, v),
+		})
+	}
+
+	return result
+}
+
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
 	result := make(KeyValueTags)
@@ -315,6 +315,365 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		want          *DefaultConfig
+	}{
+		{
+			name:          "nil",
+			defaultConfig: nil,
+			want:          nil,
+		},
+		{
+			name: "no scopes",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+		},
+		{
+			name: "matching scopes",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				ScopedTags: []ScopedDefaultConfig{
+					{
+						Scope: ResourceScope{
+							ServicePackages: []string{"s3"},
+						},
+						Tags: New(ctx, map[string]string{
+							"key2": "scoped2",
+							"key3": "scoped3",
+						}),
+					},
+					{
+						Scope: ResourceScope{
+							ResourceTypes: []string{"aws_ebs_volume"},
+						},
+						Tags: New(ctx, map[string]string{
+							"key4": "scoped4",
+						}),
+					},
+					{
+						Scope: ResourceScope{},
+						Tags: New(ctx, map[string]string{
+							"key5": "scoped5",
+						}),
+					},
+				},
+			},
+			want: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "scoped2",
+					"key3": "scoped3",
+					"key5": "scoped5",
+				}),
+			},
+		},
+		{
+			name: "no matching scopes",
+			defaultConfig: &DefaultConfig{
+				ScopedTags: []ScopedDefaultConfig{
+					{
+						Scope: ResourceScope{
+							ExcludeResourceTypes: []string{"aws_s3_bucket"},
+						},
+						Tags: New(ctx, map[string]string{
+							"key1": "scoped1",
+						}),
+					},
+				},
+			},
+			want: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource("s3", "aws_s3_bucket")
+
+			if got == nil || testCase.want == nil {
+				if got != testCase.want {
+					t.Errorf("unexpected ForResource: %v", got)
+				}
+				return
+			}
+
+			if !got.Tags.Equal(testCase.want.Tags) || len(got.ScopedTags) != 0 {
+				t.Errorf("unexpected ForResource: %v", got)
+			}
+		})
+	}
+}
+
+func TestIgnoreConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		ignoreConfig *IgnoreConfig
+		want         *IgnoreConfig
+	}{
+		{
+			name:         "nil",
+			ignoreConfig: nil,
+			want:         nil,
+		},
+		{
+			name: "matching scope",
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{"key1"}),
+				Scoped: []ScopedIgnoreConfig{
+					{
+						Scope: ResourceScope{
+							ServicePackages: []string{"ec2", "elbv2"},
+						},
+						KeyPrefixes: New(ctx, []string{"kubernetes.io/"}),
+					},
+				},
+			},
+			want: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key1"}),
+				KeyPrefixes: New(ctx, []string{"kubernetes.io/"}),
+			},
+		},
+		{
+			name: "no matching scope",
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes: New(ctx, []string{"prefix1"}),
+				Scoped: []ScopedIgnoreConfig{
+					{
+						Scope: ResourceScope{
+							ExcludeServicePackages: []string{"ec2"},
+						},
+						Keys: New(ctx, []string{"key1"}),
+					},
+				},
+			},
+			want: &IgnoreConfig{
+				KeyPrefixes: New(ctx, []string{"prefix1"}),
+			},
+		},
+		{
+			name: "only scoped",
+			ignoreConfig: &IgnoreConfig{
+				Scoped: []ScopedIgnoreConfig{
+					{
+						Scope: ResourceScope{
+							ResourceTypes: []string{"aws_s3_bucket"},
+						},
+						Keys: New(ctx, []string{"key1"}),
+					},
+				},
+			},
+			want: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.ignoreConfig.ForResource("ec2", "aws_instance")
+
+			if got == nil || testCase.want == nil {
+				if got != testCase.want {
+					t.Errorf("unexpected ForResource: %v", got)
+				}
+				return
+			}
+
+			if !got.Keys.Equal(testCase.want.Keys) || !got.KeyPrefixes.Equal(testCase.want.KeyPrefixes) || len(got.Scoped) != 0 {
+				t.Errorf("unexpected ForResource: %v", got)
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigNoncompliantKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &TagPolicyConfig{
+		TagRules: map[string]TagPolicyRule{
+			"costcenter": {
+				Key: "CostCenter",
+			},
+			"project": {},
+		},
+	}
+	testCases := []struct {
+		name   string
+		policy *TagPolicyConfig
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+			}),
+		},
+		{
+			name:   "empty",
+			policy: policy,
+			tags:   New(ctx, map[string]string{}),
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"other":      "value",
+			}),
+		},
+		{
+			name:   "no key capitalization",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"PROJECT": "value",
+			}),
+		},
+		{
+			name:   "noncompliant",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+				"COSTCENTER": "200",
+				"CostCenter": "300",
+			}),
+			want: []string{
+				"COSTCENTER (expected CostCenter)",
+				"costcenter (expected CostCenter)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.NoncompliantKeys(testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("unexpected NoncompliantKeys: %q", got)
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigNoncompliantValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &TagPolicyConfig{
+		TagRules: map[string]TagPolicyRule{
+			"costcenter": {
+				Key:           "CostCenter",
+				AllowedValues: []string{"100", "200*"},
+			},
+			"project": {
+				Key: "Project",
+			},
+		},
+	}
+	testCases := []struct {
+		name   string
+		policy *TagPolicyConfig
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300",
+			}),
+		},
+		{
+			name:   "empty",
+			policy: policy,
+			tags:   New(ctx, map[string]string{}),
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Project":    "any",
+			}),
+		},
+		{
+			name:   "wildcard",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "200-finance",
+			}),
+		},
+		{
+			name:   "key capitalization ignored",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"costcenter": "300",
+			}),
+			want: []string{
+				`costcenter="300" (allowed: 100, 200*)`,
+			},
+		},
+		{
+			name:   "no value",
+			policy: policy,
+			tags: New(ctx, map[string]*string{
+				"CostCenter": nil,
+			}),
+			want: []string{
+				`CostCenter="" (allowed: 100, 200*)`,
+			},
+		},
+		{
+			name:   "multiple",
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "200",
+				"COSTCENTER": "1000",
+			}),
+			want: []string{
+				`COSTCENTER="1000" (allowed: 100, 200*)`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.NoncompliantValues(testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("unexpected NoncompliantValues: %q", got)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"slices"
+)
+
+// ResourceScope limits provider-level tagging configuration to a subset of resource types.
+type ResourceScope struct {
+	// ResourceTypes are the Terraform resource type names included in the scope, e.g. "aws_s3_bucket"
+	ResourceTypes []string
+	// ServicePackages are the service package names included in the scope, e.g. "ec2"
+	ServicePackages []string
+	// ExcludeResourceTypes are the Terraform resource type names excluded from the scope
+	ExcludeResourceTypes []string
+	// ExcludeServicePackages are the service package names excluded from the scope
+	ExcludeServicePackages []string
+}
+
+// Matches returns whether the specified resource type is within the scope.
+//
+// Exclusions take precedence over inclusions. When no resource types or service
+// packages are included, all resource types not excluded are within the scope.
+func (s ResourceScope) Matches(servicePackageName, typeName string) bool {
+	if slices.Contains(s.ExcludeResourceTypes, typeName) || slices.Contains(s.ExcludeServicePackages, servicePackageName) {
+		return false
+	}
+
+	if len(s.ResourceTypes) == 0 && len(s.ServicePackages) == 0 {
+		return true
+	}
+
+	return slices.Contains(s.ResourceTypes, typeName) || slices.Contains(s.ServicePackages, servicePackageName)
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"testing"
+)
+
+func TestResourceScopeMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		scope              ResourceScope
+		servicePackageName string
+		typeName           string
+		want               bool
+	}{
+		{
+			name:               "empty",
+			scope:              ResourceScope{},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "resource type included",
+			scope: ResourceScope{
+				ResourceTypes: []string{"aws_ebs_volume", "aws_s3_bucket"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "resource type not included",
+			scope: ResourceScope{
+				ResourceTypes: []string{"aws_ebs_volume"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               false,
+		},
+		{
+			name: "service package included",
+			scope: ResourceScope{
+				ResourceTypes:   []string{"aws_ebs_volume"},
+				ServicePackages: []string{"s3"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "resource type excluded",
+			scope: ResourceScope{
+				ServicePackages:      []string{"s3"},
+				ExcludeResourceTypes: []string{"aws_s3_bucket"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               false,
+		},
+		{
+			name: "service package excluded",
+			scope: ResourceScope{
+				ExcludeServicePackages: []string{"ec2"},
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want:               false,
+		},
+		{
+			name: "other service package excluded",
+			scope: ResourceScope{
+				ExcludeServicePackages: []string{"ec2"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.scope.Matches(testCase.servicePackageName, testCase.typeName)
+
+			if got != testCase.want {
+				t.Errorf("unexpected Matches: %t", got)
+			}
+		})
+	}
+}
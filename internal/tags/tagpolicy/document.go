@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// GetTagRules retrieves the tag key capitalization and allowed tag values
+// defined in the effective tag policy
+func GetTagRules(ctx context.Context, awsConfig aws.Config) (map[string]tftags.TagPolicyRule, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	output, err := client.DescribeEffectivePolicy(ctx, &organizations.DescribeEffectivePolicyInput{
+		PolicyType: orgtypes.EffectivePolicyTypeTagPolicy,
+	})
+
+	// No tag policy is attached to the account.
+	if errs.IsA[*orgtypes.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	doc, err := parseDocument(aws.ToString(output.EffectivePolicy.PolicyContent))
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.tagRules(), nil
+}
+
+// document is an Organizations tag policy document
+//
+// Effective policies contain plain values, while policies as authored may wrap
+// each value in an inheritance operator such as "@@assign".
+type document struct {
+	Tags map[string]documentTag `json:"tags"`
+}
+
+type documentTag struct {
+	TagKey               documentValue[string]   `json:"tag_key"`
+	TagValue             documentValue[[]string] `json:"tag_value"`
+	ReportRequiredTagFor documentValue[[]string] `json:"report_required_tag_for"`
+}
+
+// documentValue is a tag policy value that is either a plain JSON value or an
+// object containing the value in its "@@assign" operator.
+type documentValue[T any] struct {
+	value T
+}
+
+func (v *documentValue[T]) UnmarshalJSON(b []byte) error {
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(b, &operators); err == nil {
+		if assign, ok := operators["@@assign"]; ok {
+			return json.Unmarshal(assign, &v.value)
+		}
+		return nil
+	}
+
+	return json.Unmarshal(b, &v.value)
+}
+
+// ParseDocument translates the content of a tag policy document into the
+// required tags per Terraform resource type and the tag rules per lowercase tag key
+func ParseDocument(ctx context.Context, content string) (map[string]tftags.KeyValueTags, map[string]tftags.TagPolicyRule, error) {
+	doc, err := parseDocument(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doc.requiredTags(ctx), doc.tagRules(), nil
+}
+
+func parseDocument(s string) (*document, error) {
+	var doc document
+	if err := tfjson.DecodeFromString(s, &doc); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// tagRules translates the tag key and tag value definitions of a tag policy
+// into tag rules keyed by lowercase tag key
+func (doc *document) tagRules() map[string]tftags.TagPolicyRule {
+	m := make(map[string]tftags.TagPolicyRule)
+	for k, t := range doc.Tags {
+		m[strings.ToLower(k)] = tftags.TagPolicyRule{
+			Key:           t.TagKey.value,
+			AllowedValues: t.TagValue.value,
+		}
+	}
+	return m
+}
+
+// requiredTags translates the required tag definitions of a tag policy into a
+// map of required tags per Terraform resource type
+func (doc *document) requiredTags(ctx context.Context) map[string]tftags.KeyValueTags {
+	m := make(map[string]tftags.KeyValueTags)
+	for k, t := range doc.Tags {
+		key := t.TagKey.value
+		if key == "" {
+			key = k
+		}
+
+		newTags := tftags.New(ctx, []string{key})
+		for _, resourceType := range t.ReportRequiredTagFor.value {
+			for _, tfType := range lookupTerraformTypes(resourceType) {
+				mergeRequiredTags(m, tfType, newTags)
+			}
+		}
+	}
+	return m
+}
+
+// lookupTerraformTypes returns the Terraform resource types corresponding to a
+// Tagris resource type name, expanding "<service>:ALL_SUPPORTED" to every
+// resource type of the service
+func lookupTerraformTypes(resourceType string) []string {
+	service, ok := strings.CutSuffix(resourceType, ":ALL_SUPPORTED")
+	if !ok {
+		return Lookup[resourceType]
+	}
+
+	var tfTypes []string
+	for k, v := range Lookup {
+		if strings.HasPrefix(k, service+":") {
+			tfTypes = append(tfTypes, v...)
+		}
+	}
+	return tfTypes
+}
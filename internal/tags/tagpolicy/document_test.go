@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestDocumentTagRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		want    map[string]tftags.TagPolicyRule
+		wantErr bool
+	}{
+		"empty": {
+			content: `{}`,
+			want:    map[string]tftags.TagPolicyRule{},
+		},
+		"effective policy": {
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200*"],
+      "enforced_for": ["secretsmanager:*"]
+    },
+    "project": {
+      "tag_key": "Project"
+    }
+  }
+}`,
+			want: map[string]tftags.TagPolicyRule{
+				"costcenter": {
+					Key:           "CostCenter",
+					AllowedValues: []string{"100", "200*"},
+				},
+				"project": {
+					Key: "Project",
+				},
+			},
+		},
+		"inheritance operators": {
+			content: `{
+  "tags": {
+    "CostCenter": {
+      "tag_key": {
+        "@@assign": "CostCenter",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      },
+      "tag_value": {
+        "@@assign": ["100", "200*"]
+      }
+    },
+    "project": {
+      "tag_value": {
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      }
+    }
+  }
+}`,
+			want: map[string]tftags.TagPolicyRule{
+				"costcenter": {
+					Key:           "CostCenter",
+					AllowedValues: []string{"100", "200*"},
+				},
+				"project": {},
+			},
+		},
+		"invalid JSON": {
+			content: `{"tags":`,
+			wantErr: true,
+		},
+		"invalid value": {
+			content: `{"tags": {"project": {"tag_key": ["Project"]}}}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := parseDocument(testCase.content)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("parseDocument() err %t, want %t: %v", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(doc.tagRules(), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDocumentRequiredTags(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		content string
+		want    map[string]tftags.KeyValueTags
+	}{
+		"empty": {
+			content: `{}`,
+			want:    map[string]tftags.KeyValueTags{},
+		},
+		"no required tags": {
+			content: `{"tags": {"costcenter": {"tag_key": {"@@assign": "CostCenter"}}}}`,
+			want:    map[string]tftags.KeyValueTags{},
+		},
+		"required tags": {
+			content: `{
+  "tags": {
+    "owner": {
+      "tag_key": {
+        "@@assign": "Owner"
+      },
+      "report_required_tag_for": {
+        "@@assign": ["logs:log-group", "secretsmanager:ALL_SUPPORTED"]
+      }
+    },
+    "project": {
+      "report_required_tag_for": ["logs:log-group", "unknown:type"]
+    }
+  }
+}`,
+			want: map[string]tftags.KeyValueTags{
+				"aws_cloudwatch_log_group":  tftags.New(ctx, []string{"Owner", "project"}),
+				"aws_secretsmanager_secret": tftags.New(ctx, []string{"Owner"}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _, err := ParseDocument(ctx, testCase.content)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -41,12 +41,16 @@ func convert(ctx context.Context, reqTags []types.RequiredTag) map[string]tftags
 
 		newTags := tftags.New(ctx, t.ReportingTagKeys)
 		for _, tfType := range tfTypes {
-			if v, ok := m[tfType]; ok {
-				m[tfType] = v.Merge(newTags)
-			} else {
-				m[tfType] = newTags
-			}
+			mergeRequiredTags(m, tfType, newTags)
 		}
 	}
 	return m
 }
+
+func mergeRequiredTags(m map[string]tftags.KeyValueTags, tfType string, newTags tftags.KeyValueTags) {
+	if v, ok := m[tfType]; ok {
+		m[tfType] = v.Merge(newTags)
+	} else {
+		m[tfType] = newTags
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2199
+)
+
+// cronField describes one of the six fields of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string // Value names, starting at min.
+	step  bool     // Whether the / wildcard is allowed.
+	wrap  bool     // Whether a range may wrap around, such as FRI-MON.
+}
+
+var (
+	cronFieldMinutes    = cronField{name: "Minutes", min: 0, max: 59, step: true, wrap: true}
+	cronFieldHours      = cronField{name: "Hours", min: 0, max: 23, step: true, wrap: true}
+	cronFieldDayOfMonth = cronField{name: "Day-of-month", min: 1, max: 31, step: true, wrap: true}
+	cronFieldMonth      = cronField{name: "Month", min: 1, max: 12, step: true, wrap: true, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronFieldDayOfWeek  = cronField{name: "Day-of-week", min: 1, max: 7, wrap: true, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+	cronFieldYear       = cronField{name: "Year", min: cronMinYear, max: cronMaxYear, step: true}
+)
+
+// cronExpression is a parsed cron(minutes hours day-of-month month day-of-week year) expression.
+// Exactly one of the day-of-month and day-of-week fields is ?, and the other determines the days on which the schedule fires.
+type cronExpression struct {
+	minutes, hours, months, years []bool // Indexed by value.
+
+	// Day-of-month.
+	daysOfMonth     []bool
+	lastDayOfMonth  bool // L
+	lastWeekday     bool // LW
+	nearestWeekday  int  // nW
+	dayOfMonthIsSet bool // Whether day-of-month, rather than day-of-week, is not ?.
+
+	// Day-of-week. Sunday is 1.
+	daysOfWeek []bool
+	lastOfWeek int // nL, the last given day of the week in the month.
+	nthOfWeek  int // n#k, the k-th given day of the week in the month.
+	nth        int
+}
+
+func parseCron(s string) (*cronExpression, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", len(fields))
+	}
+
+	var (
+		cron cronExpression
+		err  error
+	)
+
+	if cron.minutes, err = cronFieldMinutes.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if cron.hours, err = cronFieldHours.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if cron.months, err = cronFieldMonth.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if cron.years, err = cronFieldYear.parse(fields[5]); err != nil {
+		return nil, err
+	}
+
+	switch dom, dow := fields[2], fields[4]; {
+	case dom == "?" && dow == "?":
+		return nil, fmt.Errorf("only one of the Day-of-month and Day-of-week fields may be ?")
+	case dom != "?" && dow != "?":
+		return nil, fmt.Errorf("one of the Day-of-month and Day-of-week fields must be ?")
+	case dom != "?":
+		cron.dayOfMonthIsSet = true
+		if err := cron.parseDayOfMonth(dom); err != nil {
+			return nil, err
+		}
+	default:
+		if err := cron.parseDayOfWeek(dow); err != nil {
+			return nil, err
+		}
+	}
+
+	return &cron, nil
+}
+
+func (cron *cronExpression) parseDayOfMonth(s string) error {
+	switch u := strings.ToUpper(s); {
+	case u == "L":
+		cron.lastDayOfMonth = true
+		return nil
+	case u == "LW":
+		cron.lastWeekday = true
+		return nil
+	case strings.HasSuffix(u, "W"):
+		v, err := cronFieldDayOfMonth.value(strings.TrimSuffix(u, "W"))
+		if err != nil {
+			return err
+		}
+		cron.nearestWeekday = v
+		return nil
+	}
+
+	var err error
+	cron.daysOfMonth, err = cronFieldDayOfMonth.parse(s)
+
+	return err
+}
+
+func (cron *cronExpression) parseDayOfWeek(s string) error {
+	switch u := strings.ToUpper(s); {
+	case u == "L":
+		// The last day of the week, Saturday.
+		cron.daysOfWeek = make([]bool, cronFieldDayOfWeek.max+1)
+		cron.daysOfWeek[cronFieldDayOfWeek.max] = true
+		return nil
+	case strings.HasSuffix(u, "L"):
+		v, err := cronFieldDayOfWeek.value(strings.TrimSuffix(u, "L"))
+		if err != nil {
+			return err
+		}
+		cron.lastOfWeek = v
+		return nil
+	case strings.Contains(u, "#"):
+		day, nth, _ := strings.Cut(u, "#")
+		v, err := cronFieldDayOfWeek.value(day)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.Atoi(nth)
+		if err != nil || n < 1 || n > 5 {
+			return fmt.Errorf("invalid %s field %q: occurrence must be between 1 and 5", cronFieldDayOfWeek.name, s)
+		}
+		cron.nthOfWeek, cron.nth = v, n
+		return nil
+	}
+
+	var err error
+	cron.daysOfWeek, err = cronFieldDayOfWeek.parse(s)
+
+	return err
+}
+
+// parse parses a field made up of a comma-separated list of *, values and ranges, each optionally with a step.
+func (f cronField) parse(s string) ([]bool, error) {
+	values := make([]bool, f.max+1)
+
+	for item := range strings.SplitSeq(s, ",") {
+		expr, stepExpr, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			if !f.step {
+				return nil, fmt.Errorf("invalid %s field %q: the / wildcard is not supported", f.name, s)
+			}
+
+			var err error
+			step, err = strconv.Atoi(stepExpr)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid %s field %q: step %q must be a positive integer", f.name, s, stepExpr)
+			}
+		}
+
+		var first, last int
+		switch from, to, isRange := strings.Cut(expr, "-"); {
+		case expr == "*":
+			first, last = f.min, f.max
+		case isRange:
+			var err error
+			if first, err = f.value(from); err != nil {
+				return nil, err
+			}
+			if last, err = f.value(to); err != nil {
+				return nil, err
+			}
+			if last < first && !f.wrap {
+				return nil, fmt.Errorf("invalid %s field %q: range %q is reversed", f.name, s, expr)
+			}
+		default:
+			var err error
+			if first, err = f.value(expr); err != nil {
+				return nil, err
+			}
+			// A value with a step, such as 0/15, runs to the end of the field's range.
+			last = first
+			if hasStep {
+				last = f.max
+			}
+		}
+
+		// A range that wraps around, such as 22-2, continues from the start of the field's range.
+		span := last - first
+		if span < 0 {
+			span += f.max - f.min + 1
+		}
+		for i := 0; i <= span; i += step {
+			values[f.min+(first-f.min+i)%(f.max-f.min+1)] = true
+		}
+	}
+
+	return values, nil
+}
+
+// value parses a single value, either a number or, case-insensitively, a name.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s value %q: must be between %d and %d", f.name, s, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// next returns the first time after the specified time that matches the expression, evaluated in the specified location.
+func (cron *cronExpression) next(after time.Time, loc *time.Location) (time.Time, bool) {
+	after = after.In(loc)
+
+	// Walk calendar days, in UTC so that every day is 24 hours long.
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC)
+
+	for day.Year() <= cronMaxYear {
+		if day.Year() < cronMinYear || !cron.years[day.Year()] {
+			day = time.Date(day.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !cron.months[day.Month()] {
+			day = time.Date(day.Year(), day.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if cron.matchesDay(day) {
+			// Times that fall in a daylight saving time gap are moved forward past the gap, which can reorder them,
+			// so take the earliest of the day's matching times.
+			var next time.Time
+			for hour, ok := range cron.hours {
+				if !ok {
+					continue
+				}
+				for minute, ok := range cron.minutes {
+					if !ok {
+						continue
+					}
+
+					t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+					want := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+					if got := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC); !got.Equal(want) {
+						t = t.Add(want.Sub(got))
+					}
+					if t.After(after) && (next.IsZero() || t.Before(next)) {
+						next = t
+					}
+				}
+			}
+
+			if !next.IsZero() {
+				return next, true
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+func (cron *cronExpression) matchesDay(day time.Time) bool {
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if cron.dayOfMonthIsSet {
+		switch {
+		case cron.lastDayOfMonth:
+			return day.Day() == lastDay
+		case cron.lastWeekday:
+			return day.Day() == nearestWeekday(day, lastDay, lastDay)
+		case cron.nearestWeekday > 0:
+			if cron.nearestWeekday > lastDay {
+				return false
+			}
+			return day.Day() == nearestWeekday(day, cron.nearestWeekday, lastDay)
+		default:
+			return cron.daysOfMonth[day.Day()]
+		}
+	}
+
+	weekday := int(day.Weekday()) + 1
+	switch {
+	case cron.lastOfWeek > 0:
+		return weekday == cron.lastOfWeek && day.Day()+7 > lastDay
+	case cron.nthOfWeek > 0:
+		return weekday == cron.nthOfWeek && (day.Day()-1)/7+1 == cron.nth
+	default:
+		return cron.daysOfWeek[weekday]
+	}
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to the specified day of the month in which day falls,
+// without crossing into another month.
+func nearestWeekday(day time.Time, dayOfMonth, lastDay int) int {
+	switch time.Date(day.Year(), day.Month(), dayOfMonth, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if dayOfMonth == 1 {
+			return dayOfMonth + 2
+		}
+		return dayOfMonth - 1
+	case time.Sunday:
+		if dayOfMonth == lastDay {
+			return dayOfMonth - 2
+		}
+		return dayOfMonth + 1
+	default:
+		return dayOfMonth
+	}
+}
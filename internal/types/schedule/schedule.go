@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is the kind of a schedule expression.
+type Kind string
+
+const (
+	KindAt   Kind = "at"
+	KindCron Kind = "cron"
+	KindRate Kind = "rate"
+)
+
+const (
+	atLayout = "2006-01-02T15:04:05"
+)
+
+// Expression is a parsed AWS schedule expression, as used by EventBridge rules, EventBridge Scheduler,
+// AWS Backup plans, SSM maintenance windows and Glue triggers:
+//
+//   - at(yyyy-mm-ddThh:mm:ss), a one-time schedule
+//   - cron(minutes hours day-of-month month day-of-week year), AWS's six-field cron dialect
+//   - rate(value unit), a fixed-interval schedule in minutes, hours or days
+//
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+type Expression struct {
+	kind Kind
+	at   time.Time // Wall clock time, in UTC.
+	cron *cronExpression
+	rate time.Duration
+}
+
+// ParseOption modifies how Parse parses an expression.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	anyRateUnitNumber bool
+}
+
+// WithAnyRateUnitNumber accepts a singular or plural unit in a rate expression whatever its value,
+// e.g. rate(1 hours), as EventBridge Scheduler does.
+func WithAnyRateUnitNumber() ParseOption {
+	return func(o *parseOptions) {
+		o.anyRateUnitNumber = true
+	}
+}
+
+// Parse parses an AWS schedule expression.
+func Parse(s string, optFns ...ParseOption) (Expression, error) {
+	var opts parseOptions
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	kind, body, ok := strings.Cut(s, "(")
+	if !ok || !strings.HasSuffix(body, ")") {
+		return Expression{}, fmt.Errorf("schedule expression %q must be of the form at(...), cron(...) or rate(...)", s)
+	}
+	body = strings.TrimSuffix(body, ")")
+
+	switch Kind(kind) {
+	case KindAt:
+		t, err := time.Parse(atLayout, body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("at expression %q must be of the form at(yyyy-mm-ddThh:mm:ss)", s)
+		}
+
+		return Expression{kind: KindAt, at: t}, nil
+
+	case KindCron:
+		cron, err := parseCron(body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("cron expression %q: %w", s, err)
+		}
+
+		return Expression{kind: KindCron, cron: cron}, nil
+
+	case KindRate:
+		rate, err := parseRate(body, opts.anyRateUnitNumber)
+		if err != nil {
+			return Expression{}, fmt.Errorf("rate expression %q: %w", s, err)
+		}
+
+		return Expression{kind: KindRate, rate: rate}, nil
+	}
+
+	return Expression{}, fmt.Errorf("schedule expression %q must be of the form at(...), cron(...) or rate(...)", s)
+}
+
+// Kind returns the kind of the expression.
+func (e Expression) Kind() Kind {
+	return e.kind
+}
+
+// Next returns the first time after the specified time at which the schedule fires, with the expression evaluated
+// in the specified location. The second return value is false if the schedule never fires again.
+// The first run of a rate expression is one interval after the specified time.
+func (e Expression) Next(after time.Time, loc *time.Location) (time.Time, bool) {
+	switch e.kind {
+	case KindAt:
+		t := time.Date(e.at.Year(), e.at.Month(), e.at.Day(), e.at.Hour(), e.at.Minute(), e.at.Second(), 0, loc)
+		if !t.After(after) {
+			return time.Time{}, false
+		}
+
+		return t, true
+
+	case KindCron:
+		return e.cron.next(after, loc)
+
+	case KindRate:
+		return after.Add(e.rate).In(loc), true
+	}
+
+	return time.Time{}, false
+}
+
+func parseRate(s string, anyUnitNumber bool) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("must be of the form rate(value unit)")
+	}
+
+	value, err := strconv.Atoi(fields[0])
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("value %q must be a positive integer", fields[0])
+	}
+
+	var unit time.Duration
+	switch fields[1] {
+	case "minute", "minutes":
+		unit = time.Minute
+	case "hour", "hours":
+		unit = time.Hour
+	case "day", "days":
+		unit = 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("unit %q must be one of minute, minutes, hour, hours, day or days", fields[1])
+	}
+
+	// A value of 1 requires a singular unit, and any other value a plural unit.
+	if plural := strings.HasSuffix(fields[1], "s"); !anyUnitNumber && plural == (value == 1) {
+		if plural {
+			return 0, fmt.Errorf("unit %q must be singular for a value of 1", fields[1])
+		}
+		return 0, fmt.Errorf("unit %q must be plural for a value other than 1", fields[1])
+	}
+
+	return time.Duration(value) * unit, nil
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input        string
+		options      []ParseOption
+		expectedKind Kind
+		expectErr    bool
+	}{
+		"empty":                        {input: "", expectErr: true},
+		"unknown kind":                 {input: "every(5 minutes)", expectErr: true},
+		"no closing parenthesis":       {input: "rate(5 minutes", expectErr: true},
+		"at":                           {input: "at(2026-10-17T09:30:00)", expectedKind: KindAt},
+		"at invalid":                   {input: "at(2026-10-17 09:30)", expectErr: true},
+		"rate singular":                {input: "rate(1 hour)", expectedKind: KindRate},
+		"rate plural":                  {input: "rate(5 minutes)", expectedKind: KindRate},
+		"rate singular unit for many":  {input: "rate(5 minute)", expectErr: true},
+		"rate plural unit for one":     {input: "rate(1 days)", expectErr: true},
+		"rate any unit number":         {input: "rate(1 hours)", options: []ParseOption{WithAnyRateUnitNumber()}, expectedKind: KindRate},
+		"rate zero":                    {input: "rate(0 minutes)", expectErr: true},
+		"rate unsupported unit":        {input: "rate(2 weeks)", expectErr: true},
+		"cron every 10 minutes":        {input: "cron(0/10 * ? * MON-FRI *)", expectedKind: KindCron},
+		"cron last day of month":       {input: "cron(15 10 L * ? *)", expectedKind: KindCron},
+		"cron nearest weekday":         {input: "cron(0 9 3W * ? *)", expectedKind: KindCron},
+		"cron last Friday":             {input: "cron(0 18 ? * 6L 2026-2030)", expectedKind: KindCron},
+		"cron second Tuesday":          {input: "cron(0 0 ? * TUE#2 *)", expectedKind: KindCron},
+		"cron month names":             {input: "cron(0 0 1 jan,jul ? *)", expectedKind: KindCron},
+		"cron wrapping range":          {input: "cron(0 22-2 ? * FRI-MON *)", expectedKind: KindCron},
+		"cron five fields":             {input: "cron(0 12 * * ?)", expectErr: true},
+		"cron both days set":           {input: "cron(0 12 * * MON *)", expectErr: true},
+		"cron neither day set":         {input: "cron(0 12 ? * ? *)", expectErr: true},
+		"cron minute out of range":     {input: "cron(60 12 * * ? *)", expectErr: true},
+		"cron hour out of range":       {input: "cron(0 24 * * ? *)", expectErr: true},
+		"cron day of week zero":        {input: "cron(0 12 ? * 0 *)", expectErr: true},
+		"cron day of week step":        {input: "cron(0 12 ? * 1/2 *)", expectErr: true},
+		"cron invalid month name":      {input: "cron(0 12 1 FOO ? *)", expectErr: true},
+		"cron year out of range":       {input: "cron(0 12 1 * ? 2200)", expectErr: true},
+		"cron reversed year range":     {input: "cron(0 12 1 * ? 2030-2026)", expectErr: true},
+		"cron zero step":               {input: "cron(*/0 12 1 * ? *)", expectErr: true},
+		"cron occurrence out of range": {input: "cron(0 12 ? * MON#6 *)", expectErr: true},
+		"cron empty list item":         {input: "cron(0 12 1,,2 * ? *)", expectErr: true},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := Parse(testcase.input, testcase.options...)
+
+			if got, want := err != nil, testcase.expectErr; got != want {
+				t.Fatalf("Parse(%q) err = %v, want error = %t", testcase.input, err, want)
+			}
+			if err == nil {
+				if got, want := expr.Kind(), testcase.expectedKind; got != want {
+					t.Errorf("Parse(%q).Kind() = %q, want %q", testcase.input, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExpressionNext(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		input    string
+		loc      *time.Location
+		after    time.Time
+		expected []string
+	}{
+		"at future": {
+			input:    "at(2026-10-17T09:30:00)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-10-17T09:30:00Z"},
+		},
+		"at past": {
+			input: "at(2026-10-17T09:30:00)",
+			loc:   time.UTC,
+			after: time.Date(2026, time.October, 17, 9, 30, 0, 0, time.UTC),
+		},
+		"at location": {
+			input:    "at(2026-10-17T09:30:00)",
+			loc:      newYork,
+			after:    time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-10-17T09:30:00-04:00"},
+		},
+		"rate": {
+			input:    "rate(90 minutes)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-10-17T01:30:00Z", "2026-10-17T03:00:00Z", "2026-10-17T04:30:00Z"},
+		},
+		"cron every 15 minutes": {
+			input:    "cron(0/15 * * * ? *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 17, 9, 10, 30, 0, time.UTC),
+			expected: []string{"2026-10-17T09:15:00Z", "2026-10-17T09:30:00Z", "2026-10-17T09:45:00Z", "2026-10-17T10:00:00Z"},
+		},
+		"cron weekdays": {
+			input:    "cron(0 9 ? * MON-FRI *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC), // Friday.
+			expected: []string{"2026-10-19T09:00:00Z", "2026-10-20T09:00:00Z"},
+		},
+		"cron wrapping range": {
+			input:    "cron(0 23-1 * * ? *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC),
+			expected: []string{"2026-10-17T23:00:00Z", "2026-10-18T00:00:00Z", "2026-10-18T01:00:00Z", "2026-10-18T23:00:00Z"},
+		},
+		"cron last day of month": {
+			input:    "cron(0 0 L * ? *)",
+			loc:      time.UTC,
+			after:    time.Date(2028, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2028-02-29T00:00:00Z", "2028-03-31T00:00:00Z"},
+		},
+		"cron nearest weekday": {
+			input:    "cron(0 0 1W * ? *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.July, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-08-03T00:00:00Z", "2026-09-01T00:00:00Z"}, // 1 August 2026 is a Saturday.
+		},
+		"cron last weekday": {
+			input:    "cron(0 0 LW * ? *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-05-29T00:00:00Z", "2026-06-30T00:00:00Z"}, // 31 May 2026 is a Sunday.
+		},
+		"cron last Friday": {
+			input:    "cron(0 0 ? * 6L *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-10-30T00:00:00Z", "2026-11-27T00:00:00Z"},
+		},
+		"cron second Tuesday": {
+			input:    "cron(0 0 ? * TUE#2 *)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2026-10-13T00:00:00Z", "2026-11-10T00:00:00Z"},
+		},
+		"cron years": {
+			input:    "cron(0 0 1 JAN ? 2027,2030)",
+			loc:      time.UTC,
+			after:    time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2027-01-01T00:00:00Z", "2030-01-01T00:00:00Z"},
+		},
+		"cron never": {
+			input: "cron(0 0 30 FEB ? *)",
+			loc:   time.UTC,
+			after: time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+		},
+		"cron location": {
+			input:    "cron(30 8 * * ? *)",
+			loc:      newYork,
+			after:    time.Date(2026, time.October, 31, 12, 30, 0, 0, time.UTC),
+			expected: []string{"2026-11-01T08:30:00-05:00", "2026-11-02T08:30:00-05:00"},
+		},
+		"cron daylight saving time gap": {
+			input:    "cron(30 2 * * ? *)",
+			loc:      newYork,
+			after:    time.Date(2026, time.March, 7, 12, 0, 0, 0, newYork),
+			expected: []string{"2026-03-08T03:30:00-04:00", "2026-03-09T02:30:00-04:00"},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := Parse(testcase.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) err = %v", testcase.input, err)
+			}
+
+			var got []string
+			after := testcase.after
+			for range max(len(testcase.expected), 1) {
+				next, ok := expr.Next(after, testcase.loc)
+				if !ok {
+					break
+				}
+				got = append(got, next.Format(time.RFC3339))
+				after = next
+			}
+
+			if !slices.Equal(got, testcase.expected) {
+				t.Errorf("Next() = %v, want %v", got, testcase.expected)
+			}
+		})
+	}
+}
@@ -14,8 +14,9 @@ const (
 	envVarVCRMode = "VCR_MODE"
 	envVarVCRPath = "VCR_PATH"
 
-	vcrModeRecordOnly = "RECORD_ONLY"
-	vcrModeReplayOnly = "REPLAY_ONLY"
+	vcrModeNewEpisodes = "NEW_EPISODES"
+	vcrModeRecordOnly  = "RECORD_ONLY"
+	vcrModeReplayOnly  = "REPLAY_ONLY"
 )
 
 // IsEnabled indicates whether VCR testing is enabled
@@ -29,6 +30,8 @@ func IsEnabled() bool {
 // Mode returns the VCR recording mode inferred from the VCR_MODE environment variable
 func Mode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
+	case vcrModeNewEpisodes:
+		return recorder.ModeReplayWithNewEpisodes, nil
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
 	case vcrModeReplayOnly:
@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+var (
+	// idempotencyTokenFields are request fields populated with a random value on each request.
+	idempotencyTokenFields = []string{
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+	}
+
+	// presignQueryParameters are SigV4 query string parameters which change on each request.
+	presignQueryParameters = []string{
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+		"X-Amz-SignedHeaders",
+	}
+
+	volatileFieldsMu sync.RWMutex
+	// volatileFields are request fields, keyed by SigV4 signing name, whose values change on each request.
+	volatileFields = map[string][]string{
+		"logs":       {"endTime", "startTime", "timestamp"},
+		"monitoring": {"EndTime", "StartTime"},
+	}
+
+	signingNameRegexp = regexache.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`)
+)
+
+// RegisterVolatileFields registers request fields whose values change on each request
+// to the service with the specified SigV4 signing name, e.g. "ec2"
+//
+// Volatile fields are ignored when matching requests to recorded interactions.
+// Field names are matched case-insensitively at any depth in JSON, XML and query protocol request bodies
+// and in URL query strings.
+func RegisterVolatileFields(signingName string, fields ...string) {
+	volatileFieldsMu.Lock()
+	defer volatileFieldsMu.Unlock()
+
+	volatileFields[signingName] = append(volatileFields[signingName], fields...)
+}
+
+// ignoredFields returns the request fields ignored when matching the specified request.
+func ignoredFields(r *http.Request) []string {
+	signingName := ""
+	if m := signingNameRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		signingName = m[1]
+	} else if r.URL != nil {
+		signingName, _, _ = strings.Cut(r.URL.Hostname(), ".")
+	}
+
+	volatileFieldsMu.RLock()
+	defer volatileFieldsMu.RUnlock()
+
+	return slices.Concat(idempotencyTokenFields, volatileFields[signingName])
+}
+
+func isIgnoredField(fields []string, name string) bool {
+	return slices.ContainsFunc(fields, func(field string) bool {
+		return strings.EqualFold(field, name)
+	})
+}
+
+// matcherFunc defines how VCR will match requests to stored interactions.
+//
+// Requests match when the method, URL, X-Amz-Target header and body are the same,
+// ignoring SigV4 signatures, idempotency tokens, volatile fields and JSON object key order.
+// Other request headers are not compared.
+func matcherFunc(ctx context.Context) recorder.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		fields := ignoredFields(r)
+
+		if normalizeURL(r.URL.String(), fields) != normalizeURL(i.URL, fields) {
+			return false
+		}
+
+		if target := r.Header.Get("X-Amz-Target"); target != "" {
+			if v := i.Headers.Get("X-Amz-Target"); v != "" && v != target {
+				return false
+			}
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body, cassetteBody := redact(b.String()), redact(i.Body)
+		// If body matches identically, we are done.
+		if body == cassetteBody {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		mediaType, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+		switch mediaType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			requestJSON, err := normalizeJSON(body, fields)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteJSON, err := normalizeJSON(cassetteBody, fields)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			return tfjson.EqualStrings(requestJSON, cassetteJSON)
+
+		case "application/xml", "text/xml":
+			requestXML, err := normalizeXML(body, fields)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteXML, err := normalizeXML(cassetteBody, fields)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			return requestXML == cassetteXML
+
+		case "application/x-www-form-urlencoded":
+			requestForm, err := normalizeForm(body, fields)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteForm, err := normalizeForm(cassetteBody, fields)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestForm, cassetteForm)
+		}
+
+		return false
+	}
+}
+
+// normalizeURL removes SigV4 presigning parameters and ignored fields from a URL's query string.
+func normalizeURL(s string, fields []string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.RawQuery == "" {
+		return redact(s)
+	}
+
+	query := u.Query()
+	for k := range query {
+		if slices.Contains(presignQueryParameters, k) || isIgnoredField(fields, k) {
+			query.Del(k)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return redact(u.String())
+}
+
+// normalizeJSON removes ignored fields, at any depth, from a JSON document.
+func normalizeJSON(s string, fields []string) (string, error) {
+	if s == "" {
+		return s, nil
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(removeJSONFields(v, fields))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func removeJSONFields(v any, fields []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isIgnoredField(fields, k) {
+				delete(v, k)
+				continue
+			}
+			v[k] = removeJSONFields(e, fields)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = removeJSONFields(e, fields)
+		}
+	}
+
+	return v
+}
+
+// normalizeXML returns a canonical form of an XML document, without insignificant whitespace,
+// with attributes in a consistent order and with ignored elements removed.
+func normalizeXML(s string, fields []string) (string, error) {
+	var sb strings.Builder
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	skip := 0
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if skip > 0 || isIgnoredField(fields, token.Name.Local) {
+				skip++
+				continue
+			}
+
+			attrs := make([]string, 0, len(token.Attr))
+			for _, attr := range token.Attr {
+				attrs = append(attrs, attr.Name.Local+"="+attr.Value)
+			}
+			slices.Sort(attrs)
+			sb.WriteString("<" + token.Name.Local)
+			for _, attr := range attrs {
+				sb.WriteString(" " + attr)
+			}
+			sb.WriteString(">")
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			sb.WriteString("</" + token.Name.Local + ">")
+		case xml.CharData:
+			if skip > 0 {
+				continue
+			}
+
+			sb.Write(bytes.TrimSpace(token))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// normalizeForm parses a query protocol request body, removing ignored fields.
+// Field names are compared without any list or structure prefix or index suffix, e.g. "Tags.member.1.Key" is compared as "Key".
+func normalizeForm(s string, fields []string) (url.Values, error) {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range values {
+		parts := strings.Split(k, ".")
+		name := parts[len(parts)-1]
+		for len(parts) > 1 && isNumeric(name) {
+			parts = parts[:len(parts)-1]
+			name = parts[len(parts)-1]
+		}
+
+		if isIgnoredField(fields, name) {
+			values.Del(k)
+		}
+	}
+
+	return values, nil
+}
+
+func isNumeric(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
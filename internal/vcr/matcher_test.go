@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestMatcherFunc(t *testing.T) {
+	t.Parallel()
+
+	const (
+		authorizationEC2  = "AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE/20250101/us-west-2/ec2/aws4_request, SignedHeaders=host, Signature=abc"
+		authorizationLogs = "AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE/20250101/us-west-2/logs/aws4_request, SignedHeaders=host, Signature=abc"
+	)
+
+	testCases := map[string]struct {
+		method        string
+		url           string
+		headers       map[string]string
+		body          string
+		recorded      cassette.Request
+		expectedMatch bool
+	}{
+		"JSON key order": {
+			url:     "https://logs.us-west-2.amazonaws.com/",
+			headers: map[string]string{"Content-Type": "application/x-amz-json-1.1", "X-Amz-Target": "Logs_20140328.CreateLogGroup"},
+			body:    `{"logGroupName":"test","tags":{"a":"1","b":"2"}}`,
+			recorded: cassette.Request{
+				URL:     "https://logs.us-west-2.amazonaws.com/",
+				Headers: http.Header{"X-Amz-Target": {"Logs_20140328.CreateLogGroup"}},
+				Body:    `{"tags":{"b":"2","a":"1"},"logGroupName":"test"}`,
+			},
+			expectedMatch: true,
+		},
+		"different X-Amz-Target": {
+			url:     "https://logs.us-west-2.amazonaws.com/",
+			headers: map[string]string{"Content-Type": "application/x-amz-json-1.1", "X-Amz-Target": "Logs_20140328.DescribeLogGroups"},
+			body:    `{}`,
+			recorded: cassette.Request{
+				URL:     "https://logs.us-west-2.amazonaws.com/",
+				Headers: http.Header{"X-Amz-Target": {"Logs_20140328.DescribeLogStreams"}},
+				Body:    `{}`,
+			},
+		},
+		"JSON idempotency token": {
+			url:     "https://bedrock.us-west-2.amazonaws.com/custom-models",
+			headers: map[string]string{"Content-Type": "application/json"},
+			body:    `{"clientRequestToken":"b1b1","modelName":"test"}`,
+			recorded: cassette.Request{
+				URL:  "https://bedrock.us-west-2.amazonaws.com/custom-models",
+				Body: `{"clientRequestToken":"a0a0","modelName":"test"}`,
+			},
+			expectedMatch: true,
+		},
+		"JSON different value": {
+			url:     "https://bedrock.us-west-2.amazonaws.com/custom-models",
+			headers: map[string]string{"Content-Type": "application/json"},
+			body:    `{"clientRequestToken":"b1b1","modelName":"test2"}`,
+			recorded: cassette.Request{
+				URL:  "https://bedrock.us-west-2.amazonaws.com/custom-models",
+				Body: `{"clientRequestToken":"a0a0","modelName":"test"}`,
+			},
+		},
+		"JSON service volatile field": {
+			url:     "https://logs.us-west-2.amazonaws.com/",
+			headers: map[string]string{"Authorization": authorizationLogs, "Content-Type": "application/x-amz-json-1.1"},
+			body:    `{"logGroupName":"test","startTime":1700000001000}`,
+			recorded: cassette.Request{
+				URL:  "https://logs.us-west-2.amazonaws.com/",
+				Body: `{"logGroupName":"test","startTime":1700000000000}`,
+			},
+			expectedMatch: true,
+		},
+		"query idempotency token": {
+			url:     "https://ec2.us-west-2.amazonaws.com/",
+			headers: map[string]string{"Authorization": authorizationEC2, "Content-Type": "application/x-www-form-urlencoded; charset=utf-8"},
+			body:    `Action=RunInstances&ClientToken=b1b1&ImageId=ami-12345678&Version=2016-11-15`,
+			recorded: cassette.Request{
+				URL:  "https://ec2.us-west-2.amazonaws.com/",
+				Body: `Action=RunInstances&ClientToken=a0a0&ImageId=ami-12345678&Version=2016-11-15`,
+			},
+			expectedMatch: true,
+		},
+		"query different value": {
+			url:     "https://ec2.us-west-2.amazonaws.com/",
+			headers: map[string]string{"Authorization": authorizationEC2, "Content-Type": "application/x-www-form-urlencoded; charset=utf-8"},
+			body:    `Action=RunInstances&ClientToken=b1b1&ImageId=ami-87654321&Version=2016-11-15`,
+			recorded: cassette.Request{
+				URL:  "https://ec2.us-west-2.amazonaws.com/",
+				Body: `Action=RunInstances&ClientToken=a0a0&ImageId=ami-12345678&Version=2016-11-15`,
+			},
+		},
+		"XML idempotency token and whitespace": {
+			url:     "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			headers: map[string]string{"Content-Type": "application/xml"},
+			body:    `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>b1b1</CallerReference></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				URL:  "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body: "<CreateHostedZoneRequest>\n  <Name>example.com</Name>\n  <CallerReference>a0a0</CallerReference>\n</CreateHostedZoneRequest>",
+			},
+			expectedMatch: true,
+		},
+		"XML different value": {
+			url:     "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			headers: map[string]string{"Content-Type": "application/xml"},
+			body:    `<CreateHostedZoneRequest><Name>example.org</Name></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				URL:  "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body: `<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>`,
+			},
+		},
+		"presigned URL": {
+			method: http.MethodGet,
+			url:    "https://bucket.s3.us-west-2.amazonaws.com/key?X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Date=20250101T000001Z&X-Amz-Signature=def&versionId=1",
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://bucket.s3.us-west-2.amazonaws.com/key?X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Date=20250101T000000Z&X-Amz-Signature=abc&versionId=1",
+			},
+			expectedMatch: true,
+		},
+		"different URL": {
+			method: http.MethodGet,
+			url:    "https://bucket.s3.us-west-2.amazonaws.com/key?versionId=2",
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://bucket.s3.us-west-2.amazonaws.com/key?versionId=1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := testCase.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			recorded := testCase.recorded
+			if recorded.Method == "" {
+				recorded.Method = http.MethodPost
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), method, testCase.url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range testCase.headers {
+				req.Header.Set(k, v)
+			}
+
+			if got, want := matcherFunc(t.Context())(req, recorded), testCase.expectedMatch; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRegisterVolatileFields(t *testing.T) { //nolint:paralleltest // Modifies global state
+	RegisterVolatileFields("vcrtest", "RequestTime")
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, "https://vcrtest.us-west-2.amazonaws.com/", strings.NewReader(`{"Name":"test","RequestTime":2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	recorded := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://vcrtest.us-west-2.amazonaws.com/",
+		Body:   `{"Name":"test","RequestTime":1}`,
+	}
+
+	if !matcherFunc(t.Context())(req, recorded) {
+		t.Error("expected requests differing only in a registered volatile field to match")
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// Recorder records AWS API interactions to, and replays them from, a cassette
+//
+// When a request cannot be replayed, the error returned describes how the request
+// differs from the most similar interaction in the cassette.
+type Recorder struct {
+	*recorder.Recorder
+
+	cassetteName string
+}
+
+// NewRecorder returns a Recorder for the named cassette in the specified mode
+//
+// Credentials and AWS account IDs are redacted from the cassette when it is saved.
+func NewRecorder(ctx context.Context, cassetteName string, mode recorder.Mode, realTransport http.RoundTripper) (*Recorder, error) {
+	r, err := recorder.New(cassetteName,
+		recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+		recorder.WithHook(redactHook, recorder.BeforeSaveHook),
+		recorder.WithMatcher(matcherFunc(ctx)),
+		recorder.WithMode(mode),
+		recorder.WithRealTransport(realTransport),
+		recorder.WithSkipRequestLatency(true),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		Recorder:     r,
+		cassetteName: cassetteName,
+	}, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.Recorder.RoundTrip(req)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, r.newInteractionNotFoundError(req, string(body))
+	}
+
+	return resp, err
+}
+
+// InteractionNotFoundError is returned when a request does not match any interaction in the cassette
+type InteractionNotFoundError struct {
+	Method string
+	URL    string
+	// Diff describes how the request differs from the most similar interaction in the cassette.
+	// Empty if the cassette contains no interaction with the same method and URL.
+	Diff string
+	// Replayed indicates that the only matching interactions in the cassette have already been replayed.
+	Replayed bool
+}
+
+func (e *InteractionNotFoundError) Error() string {
+	switch {
+	case e.Replayed:
+		return fmt.Sprintf("%s %s: %s: matching interactions have already been replayed", e.Method, e.URL, cassette.ErrInteractionNotFound)
+	case e.Diff == "":
+		return fmt.Sprintf("%s %s: %s: no interaction with the same method and URL", e.Method, e.URL, cassette.ErrInteractionNotFound)
+	}
+
+	return fmt.Sprintf("%s %s: %s: request body differs from recorded interaction (-recorded +actual):\n%s", e.Method, e.URL, cassette.ErrInteractionNotFound, e.Diff)
+}
+
+func (e *InteractionNotFoundError) Unwrap() error {
+	return cassette.ErrInteractionNotFound
+}
+
+func (r *Recorder) newInteractionNotFoundError(req *http.Request, body string) error {
+	err := &InteractionNotFoundError{
+		Method: req.Method,
+		URL:    redact(req.URL.String()),
+	}
+
+	// The recorder does not expose its in-memory cassette, so load the saved cassette.
+	c, loadErr := cassette.Load(r.cassetteName)
+	if loadErr != nil {
+		return err
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	fields := ignoredFields(req)
+	reqURL := normalizeURL(req.URL.String(), fields)
+	actual := normalizeBody(contentType, redact(body), fields)
+	for _, i := range c.Interactions {
+		if i.Request.Method != req.Method || normalizeURL(i.Request.URL, fields) != reqURL {
+			continue
+		}
+
+		diff := cmp.Diff(normalizeBody(contentType, redact(i.Request.Body), fields), actual)
+		if diff == "" {
+			err.Replayed = true
+			continue
+		}
+		if err.Diff == "" || len(diff) < len(err.Diff) {
+			err.Diff = diff
+		}
+	}
+	if err.Diff != "" {
+		err.Replayed = false
+	}
+
+	return err
+}
+
+// normalizeBody returns a request body without ignored fields, formatted for comparison one field per line where possible.
+func normalizeBody(contentType, body string, fields []string) string {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err == nil {
+			if b, err := json.MarshalIndent(removeJSONFields(v, fields), "", "  "); err == nil {
+				return string(b)
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if values, err := normalizeForm(body, fields); err == nil {
+			var lines []string
+			for k, vs := range values {
+				for _, v := range vs {
+					lines = append(lines, k+"="+v)
+				}
+			}
+			slices.Sort(lines)
+			return strings.Join(lines, "\n")
+		}
+	}
+
+	return body
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"ARN": {
+			input:    `{"RoleArn":"arn:aws:iam::111122223333:role/test"}`,
+			expected: `{"RoleArn":"arn:aws:iam::123456789012:role/test"}`,
+		},
+		"URL-encoded ARN": {
+			input:    `/2015-03-31/functions/arn%3Aaws%3Alambda%3Aus-west-2%3A111122223333%3Afunction%3Atest`,
+			expected: `/2015-03-31/functions/arn%3Aaws%3Alambda%3Aus-west-2%3A123456789012%3Afunction%3Atest`,
+		},
+		"XML account ID": {
+			input:    `<GetCallerIdentityResult><Account>111122223333</Account></GetCallerIdentityResult>`,
+			expected: `<GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult>`,
+		},
+		"JSON account ID": {
+			input:    `{"AccountId": "111122223333"}`,
+			expected: `{"AccountId": "123456789012"}`,
+		},
+		"query account ID": {
+			input:    `Action=DescribeImages&Owner.1=self&OwnerId.1=111122223333`,
+			expected: `Action=DescribeImages&Owner.1=self&OwnerId.1=123456789012`,
+		},
+		"XML credentials": {
+			input:    `<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>`,
+			expected: `<Credentials><AccessKeyId>REDACTED</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		"JSON credentials": {
+			input:    `{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"secret","sessionToken":"token"}`,
+			expected: `{"accessKeyId":"REDACTED","secretAccessKey":"REDACTED","sessionToken":"REDACTED"}`,
+		},
+		"other 12-digit number": {
+			input:    `{"Size":111122223333}`,
+			expected: `{"Size":111122223333}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := redact(testCase.input), testCase.expected; got != want {
+				t.Errorf("redact(%q) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestRecorderReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		_, _ = io.WriteString(w, `{"Role":{"Arn":"arn:aws:iam::111122223333:role/test"},"Credentials":{"SecretAccessKey":"secret"}}`)
+	}))
+	defer server.Close()
+
+	cassetteName := filepath.Join(t.TempDir(), "TestRecorderReplay")
+	do := func(t *testing.T, r *Recorder, body string) (string, error) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		resp, err := (&http.Client{Transport: r}).Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(b), nil
+	}
+
+	r, err := NewRecorder(ctx, cassetteName, recorder.ModeRecordOnly, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := do(t, r, `{"RoleName":"test","AccountId":"111122223333"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, "111122223333") {
+		t.Errorf("recorded response was redacted: %s", got)
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = NewRecorder(ctx, cassetteName, recorder.ModeReplayOnly, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = do(t, r, `{"AccountId":"444455556666","RoleName":"test"}`)
+	if err != nil {
+		t.Fatalf("replaying request with a different account ID: %s", err)
+	}
+	if want := `{"Role":{"Arn":"arn:aws:iam::123456789012:role/test"},"Credentials":{"SecretAccessKey":"REDACTED"}}`; got != want {
+		t.Errorf("replayed response = %s, want %s", got, want)
+	}
+
+	_, err = do(t, r, `{"AccountId":"444455556666","RoleName":"test"}`)
+	notFoundErr, ok := errs.As[*InteractionNotFoundError](err)
+	if !ok {
+		t.Fatalf("expected InteractionNotFoundError, got: %v", err)
+	}
+	if !notFoundErr.Replayed {
+		t.Errorf("expected matching interaction to have been replayed")
+	}
+
+	r, err = NewRecorder(ctx, cassetteName, recorder.ModeReplayOnly, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = do(t, r, `{"AccountId":"444455556666","RoleName":"other"}`)
+	notFoundErr, ok = errs.As[*InteractionNotFoundError](err)
+	if !ok {
+		t.Fatalf("expected InteractionNotFoundError, got: %v", err)
+	}
+	if !strings.Contains(notFoundErr.Diff, `"RoleName": "test"`) || !strings.Contains(notFoundErr.Diff, `"RoleName": "other"`) {
+		t.Errorf("unexpected diff: %s", notFoundErr.Diff)
+	}
+	if !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Errorf("expected error to wrap cassette.ErrInteractionNotFound")
+	}
+}
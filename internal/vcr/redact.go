@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+const (
+	// RedactedAccountID replaces AWS account IDs in saved cassettes
+	RedactedAccountID = "123456789012"
+
+	// RedactedValue replaces credentials in saved cassettes
+	RedactedValue = "REDACTED"
+)
+
+var (
+	// ARNs, possibly URL-encoded, e.g. in REST API paths.
+	arnAccountIDRegexp = regexache.MustCompile(`(arn(?::|%3A)aws[0-9a-z-]*(?::|%3A)[0-9a-z-]*(?::|%3A)[0-9a-z-]*(?::|%3A))[0-9]{12}`)
+	// Account ID elements and attributes in XML, JSON and query protocol bodies.
+	accountIDXMLRegexp   = regexache.MustCompile(`(<(?:Account|AccountId|OwnerId|ownerId)>)[0-9]{12}(</)`)
+	accountIDJSONRegexp  = regexache.MustCompile(`("(?:Account|AccountId|OwnerId|accountId|ownerId)"\s*:\s*")[0-9]{12}(")`)
+	accountIDQueryRegexp = regexache.MustCompile(`((?:^|[?&])(?:[0-9A-Za-z.]+\.)?(?:Account|AccountId|OwnerId)(?:\.[0-9]+)?=)[0-9]{12}`)
+	// Temporary credentials returned by STS, SSO and Cognito.
+	credentialsXMLRegexp  = regexache.MustCompile(`(<(?:AccessKeyId|SecretAccessKey|SessionToken)>)[^<]*(</)`)
+	credentialsJSONRegexp = regexache.MustCompile(`("(?:AccessKeyId|SecretAccessKey|SessionToken|SecretKey|accessKeyId|secretAccessKey|sessionToken)"\s*:\s*")[^"]*(")`)
+)
+
+// sensitiveHeaderHook is an after capture hook to remove sensitive HTTP headers.
+func sensitiveHeaderHook(i *cassette.Interaction) error {
+	delete(i.Request.Headers, "Authorization")
+	delete(i.Request.Headers, "X-Amz-Security-Token")
+	return nil
+}
+
+// redactHook is a before save hook to redact credentials and AWS account IDs.
+//
+// Redaction is applied only to saved cassettes so that the provider uses real
+// values while recording. Requests are redacted in the same way when matched
+// against a saved cassette.
+func redactHook(i *cassette.Interaction) error {
+	i.Request.URL = redact(i.Request.URL)
+	i.Request.Body = redact(i.Request.Body)
+	if len(i.Request.Form) > 0 {
+		i.Request.Form, _ = url.ParseQuery(redact(i.Request.Form.Encode()))
+	}
+	if body := redact(i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+		if i.Response.ContentLength >= 0 {
+			i.Response.ContentLength = int64(len(body))
+		}
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+	return nil
+}
+
+// redact replaces credentials and AWS account IDs in s.
+func redact(s string) string {
+	s = arnAccountIDRegexp.ReplaceAllString(s, "${1}"+RedactedAccountID)
+	s = accountIDXMLRegexp.ReplaceAllString(s, "${1}"+RedactedAccountID+"${2}")
+	s = accountIDJSONRegexp.ReplaceAllString(s, "${1}"+RedactedAccountID+"${2}")
+	s = accountIDQueryRegexp.ReplaceAllString(s, "${1}"+RedactedAccountID)
+	s = credentialsXMLRegexp.ReplaceAllString(s, "${1}"+RedactedValue+"${2}")
+	s = credentialsJSONRegexp.ReplaceAllString(s, "${1}"+RedactedValue+"${2}")
+	return s
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
@@ -409,6 +411,43 @@ func ValidDuration(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidScheduleExpression returns a SchemaValidateFunc which tests if the provided value
+// is a valid AWS schedule expression and, if any kinds are specified, is of one of those kinds.
+func ValidScheduleExpression(kinds ...schedule.Kind) schema.SchemaValidateFunc {
+	return ValidScheduleExpressionWithOptions(nil, kinds...)
+}
+
+// ValidScheduleExpressionWithOptions returns a SchemaValidateFunc which tests if the provided value
+// is a valid AWS schedule expression when parsed with the specified options and, if any kinds are specified, is of one of those kinds.
+func ValidScheduleExpressionWithOptions(optFns []schedule.ParseOption, kinds ...schedule.Kind) schema.SchemaValidateFunc {
+	return func(v any, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		if value == "" {
+			return ws, errors
+		}
+
+		expr, err := schedule.Parse(value, optFns...)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %w", k, value, err))
+			return ws, errors
+		}
+
+		if len(kinds) > 0 && !slices.Contains(kinds, expr.Kind()) {
+			valid := tfslices.ApplyToAll(kinds, func(kind schedule.Kind) string {
+				return string(kind) + "()"
+			})
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: expected one of %s", k, value, strings.Join(valid, ", ")))
+		}
+
+		return ws, errors
+	}
+}
+
 // FloatGreaterThan returns a SchemaValidateFunc which tests if the provided value
 // is of type float and is greater than threshold.
 func FloatGreaterThan(threshold float64) schema.SchemaValidateFunc {
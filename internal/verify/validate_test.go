@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -630,6 +631,67 @@ func TestValidateTypeStringIsDateOrInt(t *testing.T) {
 	}
 }
 
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Value                  any
+		ValidateFunc           schema.SchemaValidateFunc
+		ExpectValidationErrors bool
+	}{
+		"accept empty value": {
+			Value:        "",
+			ValidateFunc: ValidScheduleExpression(),
+		},
+		"accept cron": {
+			Value:        "cron(0 12 * * ? *)",
+			ValidateFunc: ValidScheduleExpression(),
+		},
+		"accept rate of allowed kind": {
+			Value:        "rate(5 minutes)",
+			ValidateFunc: ValidScheduleExpression(schedule.KindCron, schedule.KindRate),
+		},
+		"reject non-string": {
+			Value:                  1,
+			ValidateFunc:           ValidScheduleExpression(),
+			ExpectValidationErrors: true,
+		},
+		"reject invalid expression": {
+			Value:                  "cron(0 12 * * * *)",
+			ValidateFunc:           ValidScheduleExpression(),
+			ExpectValidationErrors: true,
+		},
+		"reject unknown kind": {
+			Value:                  "every(5 minutes)",
+			ValidateFunc:           ValidScheduleExpression(),
+			ExpectValidationErrors: true,
+		},
+		"reject kind not allowed": {
+			Value:                  "at(2025-01-01T00:00:00)",
+			ValidateFunc:           ValidScheduleExpression(schedule.KindCron, schedule.KindRate),
+			ExpectValidationErrors: true,
+		},
+		"reject plural rate unit for one": {
+			Value:                  "rate(1 hours)",
+			ValidateFunc:           ValidScheduleExpression(),
+			ExpectValidationErrors: true,
+		},
+		"accept plural rate unit for one with option": {
+			Value:        "rate(1 hours)",
+			ValidateFunc: ValidScheduleExpressionWithOptions([]schedule.ParseOption{schedule.WithAnyRateUnitNumber()}),
+		},
+	}
+
+	for tn, tc := range cases {
+		_, errors := tc.ValidateFunc(tc.Value, tn)
+		if len(errors) > 0 && !tc.ExpectValidationErrors {
+			t.Errorf("%s: unexpected errors %s", tn, errors)
+		} else if len(errors) == 0 && tc.ExpectValidationErrors {
+			t.Errorf("%s: expected errors but got none", tn)
+		}
+	}
+}
+
 func TestFloatGreaterThan(t *testing.T) {
 	t.Parallel()
 
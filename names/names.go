@@ -168,6 +168,40 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// ServicePrincipalSuffixForPartition returns the DNS suffix of the service principal for the given service in the given partition.
+// Most service principals end in "amazonaws.com" in all partitions, but some use the partition's DNS suffix.
+// SPN region unique taken from
+// https://github.com/aws/aws-cdk/blob/main/packages/aws-cdk-lib/region-info/lib/default.ts
+func ServicePrincipalSuffixForPartition(service string, partition endpoints.Partition) string {
+	if partitionID := partition.ID(); service != "" && partitionID != endpoints.AwsPartitionID {
+		switch partitionID {
+		case endpoints.AwsIsoPartitionID:
+			switch service {
+			case "cloudhsm",
+				"config",
+				"logs",
+				"workspaces":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsIsoBPartitionID:
+			switch service {
+			case "dms",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsCnPartitionID:
+			switch service {
+			case "codedeploy",
+				"elasticmapreduce",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		}
+	}
+
+	return "amazonaws.com"
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
@@ -60,6 +60,58 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServicePrincipalSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		service  string
+		region   string
+		expected string
+	}{
+		{
+			name:     "standard",
+			service:  "logs",
+			region:   endpoints.UsWest2RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "China",
+			service:  "s3",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "China partition suffix",
+			service:  "logs",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com.cn",
+		},
+		{
+			name:     "ISO partition suffix",
+			service:  "config",
+			region:   endpoints.UsIsoEast1RegionID,
+			expected: "c2s.ic.gov",
+		},
+		{
+			name:     "ISOB",
+			service:  "config",
+			region:   endpoints.UsIsobEast1RegionID,
+			expected: "amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalSuffixForPartition(testCase.service, PartitionForRegion(testCase.region)), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 